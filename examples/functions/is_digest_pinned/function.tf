@@ -0,0 +1,8 @@
+variable "image" {
+  type = string
+
+  validation {
+    condition     = provider::podman::is_digest_pinned(var.image)
+    error_message = "The image reference must be pinned to a digest."
+  }
+}
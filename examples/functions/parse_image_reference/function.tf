@@ -0,0 +1,10 @@
+locals {
+  postgres = provider::podman::parse_image_reference("postgres:17.5")
+}
+
+# Name the container after the repository and tag of its image, e.g.
+# "postgres-17.5".
+resource "podman_container" "postgres" {
+  image = podman_image.postgres.id
+  name  = "${basename(local.postgres.repository)}-${local.postgres.tag}"
+}
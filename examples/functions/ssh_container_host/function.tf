@@ -0,0 +1,14 @@
+locals {
+  container_host = provider::podman::ssh_container_host(
+    "core",
+    "podman.example.com",
+    22,
+    "/run/podman/podman.sock",
+    file("${path.module}/ssh_host_ed25519_key.pub"),
+  )
+}
+
+resource "podman_network" "example" {
+  container_host = local.container_host
+  name           = "example"
+}
@@ -0,0 +1,21 @@
+locals {
+  container_host = "ssh://user@localhost/run/podman/podman.sock#pubkey=ssh-ed25519+AAAAC3NzaC1lZDI1NTE5AAAAIEahKBGUmHUA4MZgJ3pi4vZMfgB1KXbh33WExUh688Jh"
+}
+
+# Connect a container that is managed elsewhere (in this case, one called
+# "proxy") to a monitoring network, with a fixed address so that it can be
+# allowed through the host's firewall rules.
+
+resource "podman_network" "monitoring" {
+  container_host = local.container_host
+  dns_enabled    = true
+  name           = "monitoring"
+}
+
+resource "podman_network_attachment" "proxy_monitoring" {
+  aliases        = ["proxy-metrics"]
+  container      = "proxy"
+  container_host = local.container_host
+  ipv4_address   = "10.89.1.10"
+  network        = podman_network.monitoring.id
+}
@@ -15,6 +15,24 @@ type ContainerCreateHealthConfigJson struct {
 	Timeout       time.Duration `json:",omitempty"`
 }
 
+// Mounts a directory from an image, which is pulled if necessary. The mount is read-only unless
+// `ReadWrite` is set, in which case changes are discarded when the container is removed.
+type ContainerCreateImageVolumeJson struct {
+	Destination string
+	ReadWrite   bool
+	Source      string
+	SubPath     string `json:"subPath,omitempty"`
+}
+
+// `Size` is the maximum size of the log file in bytes, which only applies to the `k8s-file`
+// driver.
+type ContainerCreateLogConfigJson struct {
+	Driver  string            `json:"driver,omitempty"`
+	Options map[string]string `json:"options,omitempty"`
+	Path    string            `json:"path,omitempty"`
+	Size    int64             `json:"size,omitempty"`
+}
+
 type ContainerCreateMountJson struct {
 	Destination string
 	Options     []string
@@ -27,15 +45,78 @@ type ContainerCreateNamespaceJson struct {
 	Value  string `json:"value"`
 }
 
+// Mounts a Podman volume, which is created if it does not exist. Anonymous volumes are given a
+// random name instead.
+type ContainerCreateNamedVolumeJson struct {
+	Dest        string
+	IsAnonymous bool
+	Name        string
+	Options     []string
+	SubPath     string `json:"subPath,omitempty"`
+}
+
 type ContainerCreateNetworkJson struct {
-	// Nothing yet
+	Aliases       []string `json:"aliases,omitempty"`
+	InterfaceName string   `json:"interface_name,omitempty"`
+	StaticIPs     []string `json:"static_ips,omitempty"`
+	StaticMAC     string   `json:"static_mac,omitempty"`
+}
+
+// Mounts a host directory with an overlay on top of it, so that the container's changes are
+// discarded when it is removed.
+type ContainerCreateOverlayVolumeJson struct {
+	Destination string   `json:"destination"`
+	Options     []string `json:"options,omitempty"`
+	Source      string   `json:"source"`
 }
 
+// A `Range` greater than one maps that many consecutive ports, starting at `ContainerPort` and
+// `HostPort`. A `HostPort` of zero asks Podman to allocate a free port when the container is
+// created.
 type ContainerCreatePortMappingJson struct {
 	ContainerPort uint16 `json:"container_port"`
 	HostIP        string `json:"host_ip"`
 	HostPort      uint16 `json:"host_port"`
 	Protocol      string `json:"protocol,omitempty"`
+	Range         uint16 `json:"range,omitempty"`
+}
+
+// A subset of the OCI runtime spec's LinuxResources, which libpod accepts both when creating a
+// container and when updating a running container's limits.
+type ContainerCreateResourceLimitsJson struct {
+	BlockIO *ContainerCreateBlockIOJson `json:"blockIO,omitempty"`
+	CPU     *ContainerCreateCPUJson     `json:"cpu,omitempty"`
+	Memory  *ContainerCreateMemoryJson  `json:"memory,omitempty"`
+	Pids    *ContainerCreatePidsJson    `json:"pids,omitempty"`
+}
+
+type ContainerCreateBlockIOJson struct {
+	Weight *uint16 `json:"weight,omitempty"`
+}
+
+type ContainerCreateCPUJson struct {
+	Cpus   string  `json:"cpus,omitempty"`
+	Mems   string  `json:"mems,omitempty"`
+	Period *uint64 `json:"period,omitempty"`
+	Quota  *int64  `json:"quota,omitempty"`
+	Shares *uint64 `json:"shares,omitempty"`
+}
+
+type ContainerCreateMemoryJson struct {
+	Limit       *int64 `json:"limit,omitempty"`
+	Reservation *int64 `json:"reservation,omitempty"`
+	Swap        *int64 `json:"swap,omitempty"`
+}
+
+type ContainerCreatePidsJson struct {
+	Limit int64 `json:"limit"`
+}
+
+// One of the OCI runtime spec's POSIX resource limits. The type is named like `RLIMIT_NOFILE`.
+type ContainerCreateRlimitJson struct {
+	Hard uint64 `json:"hard"`
+	Soft uint64 `json:"soft"`
+	Type string `json:"type"`
 }
 
 type ContainerCreateSecretJson struct {
@@ -47,24 +128,58 @@ type ContainerCreateSecretJson struct {
 }
 
 type ContainerCreateJson struct {
-	Name          string                                `json:"name,omitempty"`
-	Image         string                                `json:"image,omitempty"`
-	Command       []string                              `json:"command,omitempty"`
-	Devices       []ContainerCreateDeviceJson           `json:"devices,omitempty"`
-	Env           map[string]string                     `json:"env,omitempty"`
-	Entrypoint    []string                              `json:"entrypoint,omitempty"`
-	HealthConfig  *ContainerCreateHealthConfigJson      `json:"healthconfig,omitempty"`
-	Labels        map[string]string                     `json:"labels"`
-	Mounts        []ContainerCreateMountJson            `json:"mounts,omitempty"`
-	Netns         ContainerCreateNamespaceJson          `json:"netns"`
-	Networks      map[string]ContainerCreateNetworkJson `json:"networks,omitempty"`
-	PortMappings  []ContainerCreatePortMappingJson      `json:"portmappings,omitempty"`
-	RestartPolicy string                                `json:"restart_policy"`
-	SecretEnv     map[string]string                     `json:"secret_env,omitempty"`
-	Secrets       []ContainerCreateSecretJson           `json:"secrets,omitempty"`
-	SelinuxOpts   []string                              `json:"selinux_opts,omitempty"`
-	User          string                                `json:"user"`
-	Userns        ContainerCreateNamespaceJson          `json:"userns"`
+	Name               string                                `json:"name,omitempty"`
+	Image              string                                `json:"image,omitempty"`
+	ApparmorProfile    string                                `json:"apparmor_profile,omitempty"`
+	CapAdd             []string                              `json:"cap_add,omitempty"`
+	CapDrop            []string                              `json:"cap_drop,omitempty"`
+	Command            []string                              `json:"command,omitempty"`
+	Devices            []ContainerCreateDeviceJson           `json:"devices,omitempty"`
+	DnsOption          []string                              `json:"dns_option,omitempty"`
+	DnsSearch          []string                              `json:"dns_search,omitempty"`
+	DnsServer          []string                              `json:"dns_server,omitempty"`
+	Env                map[string]string                     `json:"env,omitempty"`
+	EnvHost            *bool                                 `json:"env_host,omitempty"`
+	Entrypoint         []string                              `json:"entrypoint,omitempty"`
+	HealthConfig       *ContainerCreateHealthConfigJson      `json:"healthconfig,omitempty"`
+	HostAdd            []string                              `json:"hostadd,omitempty"`
+	ImageVolumes       []ContainerCreateImageVolumeJson      `json:"image_volumes,omitempty"`
+	Init               *bool                                 `json:"init,omitempty"`
+	InitPath           string                                `json:"init_path,omitempty"`
+	Hostname           string                                `json:"hostname,omitempty"`
+	Labels             map[string]string                     `json:"labels"`
+	LogConfiguration   *ContainerCreateLogConfigJson         `json:"log_configuration,omitempty"`
+	Mask               []string                              `json:"mask,omitempty"`
+	Mounts             []ContainerCreateMountJson            `json:"mounts,omitempty"`
+	Netns              ContainerCreateNamespaceJson          `json:"netns"`
+	Networks           map[string]ContainerCreateNetworkJson `json:"networks,omitempty"`
+	NoNewPrivileges    *bool                                 `json:"no_new_privileges,omitempty"`
+	OverlayVolumes     []ContainerCreateOverlayVolumeJson    `json:"overlay_volumes,omitempty"`
+	PortMappings       []ContainerCreatePortMappingJson      `json:"portmappings,omitempty"`
+	Privileged         *bool                                 `json:"privileged,omitempty"`
+	ReadOnlyFilesystem *bool                                 `json:"read_only_filesystem,omitempty"`
+	ReadWriteTmpfs     *bool                                 `json:"read_write_tmpfs,omitempty"`
+	ResourceLimits     *ContainerCreateResourceLimitsJson    `json:"resource_limits,omitempty"`
+	RestartPolicy      string                                `json:"restart_policy"`
+	RestartTries       *uint                                 `json:"restart_tries,omitempty"`
+	RLimits            []ContainerCreateRlimitJson           `json:"r_limits,omitempty"`
+	SeccompProfilePath string                                `json:"seccomp_profile_path,omitempty"`
+	SecretEnv          map[string]string                     `json:"secret_env,omitempty"`
+	Secrets            []ContainerCreateSecretJson           `json:"secrets,omitempty"`
+	SelinuxOpts        []string                              `json:"selinux_opts,omitempty"`
+	Timezone           string                                `json:"timezone,omitempty"`
+	Umask              string                                `json:"umask,omitempty"`
+	ShmSize            *int64                                `json:"shm_size,omitempty"`
+	StopSignal         *int                                  `json:"stop_signal,omitempty"`
+	StopTimeout        *uint                                 `json:"stop_timeout,omitempty"`
+	Sysctl             map[string]string                     `json:"sysctl,omitempty"`
+	Unmask             []string                              `json:"unmask,omitempty"`
+	Unsetenv           []string                              `json:"unsetenv,omitempty"`
+	User               string                                `json:"user"`
+	Userns             ContainerCreateNamespaceJson          `json:"userns"`
+	Volumes            []ContainerCreateNamedVolumeJson      `json:"volumes,omitempty"`
+	VolumesFrom        []string                              `json:"volumes_from,omitempty"`
+	WorkDir            string                                `json:"work_dir,omitempty"`
 }
 
 type ContainerCreatedJson struct {
@@ -72,6 +187,73 @@ type ContainerCreatedJson struct {
 	Warnings []string `json:"warnings"`
 }
 
+type ContainerInspectConfigJson struct {
+	Hostname   string
+	Labels     map[string]string
+	Timezone   string
+	Umask      string
+	WorkingDir string
+}
+
+type ContainerInspectHostConfigJson struct {
+	Dns        []string
+	DnsOptions []string
+	DnsSearch  []string
+	ExtraHosts []string
+	Init       bool
+	LogConfig  ContainerInspectLogConfigJson
+}
+
+// Podman reports `Size` in human-readable form, e.g. `10MB`.
+type ContainerInspectLogConfigJson struct {
+	Path string
+	Size string
+	Tag  string
+	Type string
+}
+
+type ContainerInspectHealthLogJson struct {
+	ExitCode int
+	Output   string
+}
+
+type ContainerInspectHealthJson struct {
+	Log    []ContainerInspectHealthLogJson `json:",omitempty"`
+	Status string
+}
+
+type ContainerInspectStateJson struct {
+	Health  *ContainerInspectHealthJson `json:",omitempty"`
+	Running bool
+	Status  string
+}
+
+type ContainerInspectNetworkJson struct {
+	// Only membership is needed so far
+}
+
+// Networks are keyed by network name.
+type ContainerInspectNetworkSettingsJson struct {
+	Networks map[string]ContainerInspectNetworkJson
+}
+
 type ContainerInspectJson struct {
-	Name string
+	Config          ContainerInspectConfigJson
+	HostConfig      ContainerInspectHostConfigJson
+	Id              string
+	Image           string
+	Name            string
+	NetworkSettings ContainerInspectNetworkSettingsJson
+	State           ContainerInspectStateJson
+}
+
+type ContainerListJson struct {
+	Id       string
+	Image    string
+	ImageID  string
+	Labels   map[string]string
+	Names    []string
+	Networks []string
+	Ports    []ContainerCreatePortMappingJson
+	State    string
 }
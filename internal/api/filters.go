@@ -0,0 +1,5 @@
+package api
+
+// Filters accepted by the libpod list and prune endpoints, keyed by filter name. Each filter may
+// be given several values, e.g. `{"label": ["app=web", "tier"]}`.
+type ListFilters map[string][]string
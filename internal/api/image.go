@@ -1,8 +1,11 @@
 package api
 
 type ImageJson struct {
-	Id    string   `json:"id"`
-	Names []string `json:"names"`
+	Id       string            `json:"id"`
+	Labels   map[string]string `json:"Labels,omitempty"`
+	Names    []string          `json:"names"`
+	RepoTags []string          `json:"RepoTags"`
+	Size     int64             `json:"Size,omitempty"`
 }
 
 type ImagePullErrorEvent struct {
@@ -1,9 +1,21 @@
 package api
 
 type NetworkJson struct {
-	DnsEnabled  bool   `json:"dns_enabled"`
-	Id          string `json:"id"`
-	Internal    bool   `json:"internal"`
-	Ipv6Enabled bool   `json:"ipv6_enabled"`
-	Name        string `json:"name"`
+	DnsEnabled  bool              `json:"dns_enabled"`
+	Id          string            `json:"id"`
+	Internal    bool              `json:"internal"`
+	Ipv6Enabled bool              `json:"ipv6_enabled"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Name        string            `json:"name"`
+}
+
+// The per-network options are the same as when a container is created.
+type NetworkConnectJson struct {
+	Container string `json:"container"`
+	ContainerCreateNetworkJson
+}
+
+type NetworkDisconnectJson struct {
+	Container string
+	Force     bool
 }
@@ -0,0 +1,13 @@
+package api
+
+type NetworkPruneReportJson struct {
+	Name string
+}
+
+// Reported by the container, image and volume prune endpoints. Size is the amount of disk space
+// that was reclaimed. Err is set if the object could not be removed.
+type PruneReportJson struct {
+	Err  *string `json:",omitempty"`
+	Id   string
+	Size uint64
+}
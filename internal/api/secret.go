@@ -5,7 +5,8 @@ type SecretCreateJson struct {
 }
 
 type SecretInspectSpecJson struct {
-	Name string
+	Labels map[string]string `json:",omitempty"`
+	Name   string
 }
 
 type SecretInspectJson struct {
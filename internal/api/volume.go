@@ -0,0 +1,6 @@
+package api
+
+type VolumeJson struct {
+	Labels map[string]string `json:",omitempty"`
+	Name   string
+}
@@ -8,13 +8,15 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/decafcode/terraform-provider-podman/internal/api"
 	"golang.org/x/crypto/ssh"
 )
 
 type Client struct {
-	transport io.Closer
-	http      *http.Client
-	urlBase   *url.URL
+	apiVersion string
+	transport  io.Closer
+	http       *http.Client
+	urlBase    *url.URL
 }
 
 type Config struct {
@@ -193,6 +195,17 @@ func (c *Client) resourceDelete(ctx context.Context, path string) error {
 	return checkStatus(resp)
 }
 
+// Adds a `filters` query parameter to `values` if any filters are given.
+func encodeFilters(values url.Values, filters api.ListFilters) {
+	if len(filters) == 0 {
+		return
+	}
+
+	// Marshalling a map of string slices can not fail
+	bytes, _ := json.Marshal(filters)
+	values.Set("filters", string(bytes))
+}
+
 func (c *Client) resourceGet(ctx context.Context, path string, out any) error {
 	relUrl, err := url.Parse(path)
 
@@ -224,6 +237,95 @@ func (c *Client) resourceGet(ctx context.Context, path string, out any) error {
 	return nil
 }
 
+// Sends a GET request and returns the raw response body.
+func (c *Client) resourceRead(ctx context.Context, path string) ([]byte, error) {
+	relUrl, err := url.Parse(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	absUrl := c.urlBase.ResolveReference(relUrl).String()
+	req, err := http.NewRequestWithContext(ctx, "GET", absUrl, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.http.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	err = checkStatus(resp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// Sends a POST request without a body and decodes the JSON response.
+func (c *Client) resourcePost(ctx context.Context, path string, out any) error {
+	relUrl, err := url.Parse(path)
+
+	if err != nil {
+		return err
+	}
+
+	absUrl := c.urlBase.ResolveReference(relUrl).String()
+	req, err := http.NewRequestWithContext(ctx, "POST", absUrl, nil)
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.http.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return readJson(resp, &out)
+}
+
+// Sends a POST request with a JSON body, for endpoints that don't return a JSON response.
+func (c *Client) resourceSend(ctx context.Context, path string, in any) error {
+	relUrl, err := url.Parse(path)
+
+	if err != nil {
+		return err
+	}
+
+	reader, writer := io.Pipe()
+
+	go pipeJson(writer, in)
+
+	absUrl := c.urlBase.ResolveReference(relUrl).String()
+	req, err := http.NewRequestWithContext(ctx, "POST", absUrl, reader)
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("content-type", "application/json")
+	resp, err := c.http.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return checkStatus(resp)
+}
+
 func (c *Client) resourceSignal(ctx context.Context, path string) error {
 	relUrl, err := url.Parse(path)
 
@@ -3,16 +3,25 @@ package client
 import (
 	"archive/tar"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/decafcode/terraform-provider-podman/internal/api"
 )
 
 type archiveBuilder func(writer *tar.Writer) error
 
+// A restart policy for ContainerUpdate, which libpod takes as query parameters.
+type ContainerRestartPolicy struct {
+	Policy  string
+	Retries *uint
+}
+
 func (c *Client) sendArchiveTask(ctx context.Context, nameOrId string, reader *io.PipeReader, promise chan<- error) {
 	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/archive?path=%%2F", url.PathEscape(nameOrId))
 	promise <- c.resourceStream(ctx, path, "application/x-tar", reader)
@@ -53,8 +62,15 @@ func (c *Client) ContainerCreate(ctx context.Context, in *api.ContainerCreateJso
 	return out, nil
 }
 
-func (c *Client) ContainerDelete(ctx context.Context, nameOrId string) error {
-	path := fmt.Sprintf("v5.0.0/libpod/containers/%s", url.PathEscape(nameOrId))
+// Also removes the container's anonymous volumes if `volumes` is set.
+func (c *Client) ContainerDelete(ctx context.Context, nameOrId string, volumes bool) error {
+	values := make(url.Values)
+
+	if volumes {
+		values.Set("v", "true")
+	}
+
+	path := fmt.Sprintf("v5.0.0/libpod/containers/%s?%s", url.PathEscape(nameOrId), values.Encode())
 
 	return c.resourceDelete(ctx, path)
 }
@@ -71,6 +87,123 @@ func (c *Client) ContainerInspect(ctx context.Context, nameOrId string) (*api.Co
 	return out, nil
 }
 
+func (c *Client) ContainerList(ctx context.Context, filters api.ListFilters) ([]api.ContainerListJson, error) {
+	var out []api.ContainerListJson
+	values := make(url.Values)
+	values.Set("all", "true")
+	encodeFilters(values, filters)
+	err := c.resourceGet(ctx, "v5.0.0/libpod/containers/json?"+values.Encode(), &out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (c *Client) ContainerKill(ctx context.Context, nameOrId string, signal string) error {
+	values := make(url.Values)
+	values.Set("signal", signal)
+	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/kill?%s", url.PathEscape(nameOrId), values.Encode())
+
+	return c.resourceSignal(ctx, path)
+}
+
+// Returns the last `tail` lines of a container's stdout and stderr, or all of them if `tail` is
+// zero.
+func (c *Client) ContainerLogs(ctx context.Context, nameOrId string, tail int) ([]string, error) {
+	values := make(url.Values)
+	values.Set("stderr", "true")
+	values.Set("stdout", "true")
+
+	if tail > 0 {
+		values.Set("tail", fmt.Sprintf("%d", tail))
+	}
+
+	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/logs?%s", url.PathEscape(nameOrId), values.Encode())
+	raw, err := c.resourceRead(ctx, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	text := strings.TrimSuffix(string(demuxLogs(raw, stdoutStream, stderrStream)), "\n")
+
+	if text == "" {
+		return []string{}, nil
+	}
+
+	return strings.Split(text, "\n"), nil
+}
+
+// Returns everything that a container has written to its stdout and stderr.
+func (c *Client) ContainerOutput(ctx context.Context, nameOrId string) (string, string, error) {
+	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/logs?stderr=true&stdout=true", url.PathEscape(nameOrId))
+	raw, err := c.resourceRead(ctx, path)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(demuxLogs(raw, stdoutStream)), string(demuxLogs(raw, stderrStream)), nil
+}
+
+const stdoutStream = 1
+const stderrStream = 2
+
+// Logs of containers without a terminal are multiplexed into frames, each of which has an 8 byte
+// header containing the stream number and a big-endian payload length. This returns the payloads
+// of the given streams. Logs of containers with a terminal are not multiplexed, and are treated
+// as stdout.
+func demuxLogs(raw []byte, streams ...byte) []byte {
+	out := make([]byte, 0, len(raw))
+
+	for len(raw) > 0 {
+		if len(raw) < 8 || raw[0] > 2 || raw[1] != 0 || raw[2] != 0 || raw[3] != 0 {
+			if slices.Contains(streams, stdoutStream) {
+				out = append(out, raw...)
+			}
+
+			return out
+		}
+
+		stream := raw[0]
+		size := int(binary.BigEndian.Uint32(raw[4:8]))
+		raw = raw[8:]
+
+		if size > len(raw) {
+			size = len(raw)
+		}
+
+		if slices.Contains(streams, stream) {
+			out = append(out, raw[:size]...)
+		}
+
+		raw = raw[size:]
+	}
+
+	return out
+}
+
+func (c *Client) ContainerPause(ctx context.Context, nameOrId string) error {
+	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/pause", url.PathEscape(nameOrId))
+
+	return c.resourceSignal(ctx, path)
+}
+
+func (c *Client) ContainerPrune(ctx context.Context, filters api.ListFilters) ([]api.PruneReportJson, error) {
+	var out []api.PruneReportJson
+	values := make(url.Values)
+	encodeFilters(values, filters)
+	err := c.resourcePost(ctx, "v5.0.0/libpod/containers/prune?"+values.Encode(), &out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
 func (c *Client) ContainerRename(ctx context.Context, nameOrId, newName string) error {
 	path := fmt.Sprintf(
 		"v5.0.0/libpod/containers/%s/rename?name=%s",
@@ -80,14 +213,81 @@ func (c *Client) ContainerRename(ctx context.Context, nameOrId, newName string)
 	return c.resourceSignal(ctx, path)
 }
 
+// A nil timeout uses the container's own stop timeout.
+func (c *Client) ContainerRestart(ctx context.Context, nameOrId string, timeout *int) error {
+	values := make(url.Values)
+
+	if timeout != nil {
+		values.Set("t", fmt.Sprintf("%d", *timeout))
+	}
+
+	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/restart?%s", url.PathEscape(nameOrId), values.Encode())
+
+	return c.resourceSignal(ctx, path)
+}
+
 func (c *Client) ContainerStart(ctx context.Context, nameOrId string) error {
 	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/start", url.PathEscape(nameOrId))
 
 	return c.resourceSignal(ctx, path)
 }
 
-func (c *Client) ContainerStop(ctx context.Context, nameOrId string) error {
-	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/stop?ignore=true", url.PathEscape(nameOrId))
+func (c *Client) ContainerUnpause(ctx context.Context, nameOrId string) error {
+	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/unpause", url.PathEscape(nameOrId))
+
+	return c.resourceSignal(ctx, path)
+}
+
+// Waits for a container to stop and returns its exit code.
+func (c *Client) ContainerWait(ctx context.Context, nameOrId string) (int, error) {
+	var out int
+	values := make(url.Values)
+	values.Add("condition", "exited")
+	values.Add("condition", "stopped")
+	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/wait?%s", url.PathEscape(nameOrId), values.Encode())
+	err := c.resourcePost(ctx, path, &out)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return out, nil
+}
+
+// Changes the resource limits and restart policy of an existing container, which may be running.
+// Limits that are not present in `in` are left unchanged, as is the restart policy if `restart`
+// is nil. Changing the restart policy requires Podman 5.0 or later.
+func (c *Client) ContainerUpdate(ctx context.Context, nameOrId string, in *api.ContainerCreateResourceLimitsJson, restart *ContainerRestartPolicy) error {
+	var out string
+	values := make(url.Values)
+
+	if in == nil {
+		in = &api.ContainerCreateResourceLimitsJson{}
+	}
+
+	if restart != nil {
+		values.Set("restartPolicy", restart.Policy)
+
+		if restart.Retries != nil {
+			values.Set("restartRetries", fmt.Sprintf("%d", *restart.Retries))
+		}
+	}
+
+	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/update?%s", url.PathEscape(nameOrId), values.Encode())
+
+	return c.resourceCreate(ctx, path, in, &out)
+}
+
+// A nil timeout uses the container's own stop timeout.
+func (c *Client) ContainerStop(ctx context.Context, nameOrId string, timeout *int) error {
+	values := make(url.Values)
+	values.Set("ignore", "true")
+
+	if timeout != nil {
+		values.Set("t", fmt.Sprintf("%d", *timeout))
+	}
+
+	path := fmt.Sprintf("v5.0.0/libpod/containers/%s/stop?%s", url.PathEscape(nameOrId), values.Encode())
 	err := c.resourceSignal(ctx, path)
 
 	if err != nil {
@@ -142,3 +142,33 @@ func streamEvents(in io.ReadCloser, out chan<- any) {
 		out <- err
 	}
 }
+
+func (c *Client) ImageList(ctx context.Context, filters api.ListFilters) ([]api.ImageJson, error) {
+	var out []api.ImageJson
+	values := make(url.Values)
+	encodeFilters(values, filters)
+	err := c.resourceGet(ctx, "v5.0.0/libpod/images/json?"+values.Encode(), &out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Removes dangling images, or all images that are not used by a container if `all` is set.
+// `buildCache` also removes persistent build cache mounts.
+func (c *Client) ImagePrune(ctx context.Context, all bool, buildCache bool, filters api.ListFilters) ([]api.PruneReportJson, error) {
+	var out []api.PruneReportJson
+	values := make(url.Values)
+	values.Set("all", fmt.Sprintf("%t", all))
+	values.Set("buildcache", fmt.Sprintf("%t", buildCache))
+	encodeFilters(values, filters)
+	err := c.resourcePost(ctx, "v5.0.0/libpod/images/prune?"+values.Encode(), &out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
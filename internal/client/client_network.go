@@ -8,6 +8,12 @@ import (
 	"github.com/decafcode/terraform-provider-podman/internal/api"
 )
 
+func (c *Client) NetworkConnect(ctx context.Context, nameOrId string, in *api.NetworkConnectJson) error {
+	path := fmt.Sprintf("v5.0.0/libpod/networks/%s/connect", url.PathEscape(nameOrId))
+
+	return c.resourceSend(ctx, path, in)
+}
+
 func (c *Client) NetworkCreate(ctx context.Context, in *api.NetworkJson) (*api.NetworkJson, error) {
 	var out *api.NetworkJson
 	err := c.resourceCreate(ctx, "v5.0.0/libpod/networks/create", in, &out)
@@ -25,6 +31,12 @@ func (c *Client) NetworkDelete(ctx context.Context, nameOrId string) error {
 	return c.resourceDelete(ctx, path)
 }
 
+func (c *Client) NetworkDisconnect(ctx context.Context, nameOrId string, in *api.NetworkDisconnectJson) error {
+	path := fmt.Sprintf("v5.0.0/libpod/networks/%s/disconnect", url.PathEscape(nameOrId))
+
+	return c.resourceSend(ctx, path, in)
+}
+
 func (c *Client) NetworkInspect(ctx context.Context, nameOrId string) (*api.NetworkJson, error) {
 	var out *api.NetworkJson
 	path := fmt.Sprintf("v5.0.0/libpod/networks/%s/json", url.PathEscape(nameOrId))
@@ -36,3 +48,29 @@ func (c *Client) NetworkInspect(ctx context.Context, nameOrId string) (*api.Netw
 
 	return out, nil
 }
+
+func (c *Client) NetworkList(ctx context.Context, filters api.ListFilters) ([]api.NetworkJson, error) {
+	var out []api.NetworkJson
+	values := make(url.Values)
+	encodeFilters(values, filters)
+	err := c.resourceGet(ctx, "v5.0.0/libpod/networks/json?"+values.Encode(), &out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (c *Client) NetworkPrune(ctx context.Context, filters api.ListFilters) ([]api.NetworkPruneReportJson, error) {
+	var out []api.NetworkPruneReportJson
+	values := make(url.Values)
+	encodeFilters(values, filters)
+	err := c.resourcePost(ctx, "v5.0.0/libpod/networks/prune?"+values.Encode(), &out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
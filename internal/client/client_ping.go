@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 )
@@ -28,5 +29,41 @@ func (c *Client) Ping(ctx context.Context) error {
 
 	defer resp.Body.Close()
 
-	return checkStatus(resp)
+	err = checkStatus(resp)
+
+	if err != nil {
+		return err
+	}
+
+	c.apiVersion = resp.Header.Get("Libpod-API-Version")
+
+	return nil
+}
+
+// Reports whether the container host's libpod API version was returned by the most recent Ping
+// in a recognisable format.
+func (c *Client) ApiVersionKnown() bool {
+	_, _, err := c.parseApiVersion()
+
+	return err == nil
+}
+
+// Reports whether the container host's libpod API version, as returned by the most recent Ping,
+// is at least major.minor. Returns false if the version is not known, so callers that need to
+// tell an old version apart from an unknown one should check ApiVersionKnown as well.
+func (c *Client) ApiVersionAtLeast(major int, minor int) bool {
+	actualMajor, actualMinor, err := c.parseApiVersion()
+
+	if err != nil {
+		return false
+	}
+
+	return actualMajor > major || (actualMajor == major && actualMinor >= minor)
+}
+
+func (c *Client) parseApiVersion() (int, int, error) {
+	var major, minor int
+	_, err := fmt.Sscanf(c.apiVersion, "%d.%d", &major, &minor)
+
+	return major, minor, err
 }
@@ -65,3 +65,16 @@ func (c *Client) SecretInspect(ctx context.Context, nameOrId string) (*api.Secre
 
 	return out, nil
 }
+
+func (c *Client) SecretList(ctx context.Context, filters api.ListFilters) ([]api.SecretInspectJson, error) {
+	var out []api.SecretInspectJson
+	values := make(url.Values)
+	encodeFilters(values, filters)
+	err := c.resourceGet(ctx, "v5.0.0/libpod/secrets/json?"+values.Encode(), &out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+)
+
+func (c *Client) VolumeList(ctx context.Context, filters api.ListFilters) ([]api.VolumeJson, error) {
+	var out []api.VolumeJson
+	values := make(url.Values)
+	encodeFilters(values, filters)
+	err := c.resourceGet(ctx, "v5.0.0/libpod/volumes/json?"+values.Encode(), &out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (c *Client) VolumePrune(ctx context.Context, filters api.ListFilters) ([]api.PruneReportJson, error) {
+	var out []api.PruneReportJson
+	values := make(url.Values)
+	encodeFilters(values, filters)
+	err := c.resourcePost(ctx, "v5.0.0/libpod/volumes/prune?"+values.Encode(), &out)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/client"
 	"github.com/decafcode/terraform-provider-podman/internal/testutil"
 	"gotest.tools/v3/assert"
 )
@@ -78,7 +79,14 @@ func TestContainerArchive(t *testing.T) {
 }
 
 func TestContainerCreate(t *testing.T) {
-	apiServer := &testutil.ApiServer{}
+	apiServer := &testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
 
 	f, err := spawnFramework(t.Context(), apiServer)
 	assert.NilError(t, err)
@@ -113,11 +121,14 @@ func TestContainerDelete(t *testing.T) {
 
 	defer f.Stop(t.Context())
 
-	err = f.ContainerDelete(t.Context(), c.Json.Name)
+	err = f.ContainerDelete(t.Context(), c.Json.Name, true)
 	assert.NilError(t, err)
 
 	_, err = f.ContainerInspect(t.Context(), c.Json.Name)
 	assert.ErrorContains(t, err, "not found")
+
+	assert.Equal(t, len(apiServer.Removed), 1)
+	assert.Equal(t, apiServer.Removed[0].Volumes, true)
 }
 
 func TestContainerInspect(t *testing.T) {
@@ -142,9 +153,202 @@ func TestContainerInspect(t *testing.T) {
 
 	actual, err := f.ContainerInspect(t.Context(), c2.Json.Name)
 	assert.NilError(t, err)
+	assert.Equal(t, c2.Id, actual.Id)
 	assert.Equal(t, c2.Json.Name, actual.Name)
 }
 
+func TestContainerKill(t *testing.T) {
+	c := &testutil.TestContainer{
+		Id:      "1",
+		Json:    api.ContainerCreateJson{Name: "one"},
+		Running: true,
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	err = f.ContainerKill(t.Context(), c.Json.Name, "SIGHUP")
+	assert.NilError(t, err)
+
+	result, err := apiServer.CaptureContainer(c.Json.Name)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result.SignalLog, []string{"SIGHUP"})
+	assert.Equal(t, result.Running, true)
+}
+
+func TestContainerList(t *testing.T) {
+	c1 := &testutil.TestContainer{
+		Id:   "1",
+		Json: api.ContainerCreateJson{Name: "one"},
+	}
+
+	c2 := &testutil.TestContainer{
+		Id: "2",
+		Json: api.ContainerCreateJson{
+			Image:  "imageid",
+			Labels: map[string]string{"app": "web"},
+			Name:   "two",
+			PortMappings: []api.ContainerCreatePortMappingJson{
+				{
+					ContainerPort: 80,
+					HostPort:      8080,
+				},
+			},
+		},
+		Running: true,
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c1, c2},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	actual, err := f.ContainerList(t.Context(), nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, actual, []api.ContainerListJson{
+		{
+			Id:    c1.Id,
+			Names: []string{c1.Json.Name},
+			State: "created",
+		},
+		{
+			Id:      c2.Id,
+			Image:   c2.Json.Image,
+			ImageID: c2.Json.Image,
+			Labels:  c2.Json.Labels,
+			Names:   []string{c2.Json.Name},
+			Ports:   c2.Json.PortMappings,
+			State:   "running",
+		},
+	})
+
+	filtered, err := f.ContainerList(t.Context(), api.ListFilters{"label": {"app=web"}})
+	assert.NilError(t, err)
+	assert.Equal(t, len(filtered), 1)
+	assert.Equal(t, filtered[0].Id, c2.Id)
+}
+
+func TestContainerLogs(t *testing.T) {
+	c := &testutil.TestContainer{
+		Id:   "1",
+		Json: api.ContainerCreateJson{Name: "one"},
+		Logs: []string{"one", "two", "three"},
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	lines, err := f.ContainerLogs(t.Context(), c.Id, 0)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, lines, []string{"one", "two", "three"})
+
+	lines, err = f.ContainerLogs(t.Context(), c.Id, 2)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, lines, []string{"two", "three"})
+}
+
+func TestContainerOutput(t *testing.T) {
+	c := &testutil.TestContainer{
+		ErrorLogs: []string{"oops"},
+		Id:        "1",
+		Json:      api.ContainerCreateJson{Name: "one"},
+		Logs:      []string{"one", "two"},
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	stdout, stderr, err := f.ContainerOutput(t.Context(), c.Id)
+	assert.NilError(t, err)
+	assert.Equal(t, stdout, "one\ntwo\n")
+	assert.Equal(t, stderr, "oops\n")
+}
+
+func TestContainerPause(t *testing.T) {
+	c := &testutil.TestContainer{
+		Id:      "1",
+		Json:    api.ContainerCreateJson{Name: "one"},
+		Running: true,
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	err = f.ContainerPause(t.Context(), c.Id)
+	assert.NilError(t, err)
+
+	result, err := f.ContainerInspect(t.Context(), c.Id)
+	assert.NilError(t, err)
+	assert.Equal(t, result.State.Status, "paused")
+
+	err = f.ContainerPause(t.Context(), c.Id)
+	assert.ErrorContains(t, err, "container state improper")
+
+	err = f.ContainerUnpause(t.Context(), c.Id)
+	assert.NilError(t, err)
+
+	result, err = f.ContainerInspect(t.Context(), c.Id)
+	assert.NilError(t, err)
+	assert.Equal(t, result.State.Status, "running")
+}
+
+func TestContainerPrune(t *testing.T) {
+	stopped := &testutil.TestContainer{
+		Id:   "1",
+		Json: api.ContainerCreateJson{Name: "stopped"},
+	}
+
+	running := &testutil.TestContainer{
+		Id:      "2",
+		Json:    api.ContainerCreateJson{Name: "running"},
+		Running: true,
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{stopped, running},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	reports, err := f.ContainerPrune(t.Context(), nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, reports, []api.PruneReportJson{{Id: stopped.Id}})
+
+	_, err = apiServer.CaptureContainer(stopped.Id)
+	assert.ErrorContains(t, err, "not found")
+}
+
 func TestContainerRename(t *testing.T) {
 	c := &testutil.TestContainer{
 		Id:   "1",
@@ -168,6 +372,32 @@ func TestContainerRename(t *testing.T) {
 	assert.Equal(t, result.Name, "after")
 }
 
+func TestContainerRestart(t *testing.T) {
+	c := &testutil.TestContainer{
+		Id:      "1",
+		Json:    api.ContainerCreateJson{Name: "one"},
+		Running: false,
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	timeout := 5
+	err = f.ContainerRestart(t.Context(), c.Id, &timeout)
+	assert.NilError(t, err)
+
+	result, err := apiServer.CaptureContainer(c.Json.Name)
+	assert.NilError(t, err)
+	assert.Equal(t, result.Restarts, 1)
+	assert.Equal(t, result.Running, true)
+}
+
 func TestContainerStart(t *testing.T) {
 	c := &testutil.TestContainer{
 		Id:      "1",
@@ -208,10 +438,73 @@ func TestContainerStop(t *testing.T) {
 
 	defer f.Stop(t.Context())
 
-	err = f.ContainerStop(t.Context(), c.Id)
+	err = f.ContainerStop(t.Context(), c.Id, nil)
 	assert.NilError(t, err)
 
 	result, err := apiServer.CaptureContainer(c.Json.Name)
 	assert.NilError(t, err)
 	assert.Equal(t, result.Running, false)
 }
+
+func TestContainerUpdate(t *testing.T) {
+	limit := int64(1073741824)
+	c := &testutil.TestContainer{
+		Id: "1",
+		Json: api.ContainerCreateJson{
+			Name: "one",
+			ResourceLimits: &api.ContainerCreateResourceLimitsJson{
+				Pids: &api.ContainerCreatePidsJson{Limit: 100},
+			},
+		},
+		Running: true,
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	retries := uint(3)
+	err = f.ContainerUpdate(t.Context(), c.Id, &api.ContainerCreateResourceLimitsJson{
+		Memory: &api.ContainerCreateMemoryJson{Limit: &limit},
+	}, &client.ContainerRestartPolicy{Policy: "on-failure", Retries: &retries})
+	assert.NilError(t, err)
+
+	result, err := apiServer.CaptureContainer(c.Json.Name)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result.Json.ResourceLimits, &api.ContainerCreateResourceLimitsJson{
+		Memory: &api.ContainerCreateMemoryJson{Limit: &limit},
+		Pids:   &api.ContainerCreatePidsJson{Limit: 100},
+	})
+	assert.Equal(t, result.Json.RestartPolicy, "on-failure")
+	assert.DeepEqual(t, result.Json.RestartTries, &retries)
+}
+
+func TestContainerWait(t *testing.T) {
+	exitCode := 3
+	c := &testutil.TestContainer{
+		ExitCode: &exitCode,
+		Id:       "1",
+		Json:     api.ContainerCreateJson{Name: "one"},
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	err = f.ContainerStart(t.Context(), c.Id)
+	assert.NilError(t, err)
+
+	result, err := f.ContainerWait(t.Context(), c.Id)
+	assert.NilError(t, err)
+	assert.Equal(t, result, 3)
+}
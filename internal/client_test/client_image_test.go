@@ -98,3 +98,33 @@ func TestImagePullFailure(t *testing.T) {
 
 	assert.Assert(t, gotError)
 }
+
+func TestImagePrune(t *testing.T) {
+	tagged := &api.ImageJson{
+		Id:    "1",
+		Names: []string{"example.com/foo:v1"},
+		Size:  2000,
+	}
+
+	dangling := &api.ImageJson{
+		Id:   "2",
+		Size: 1000,
+	}
+
+	apiServer := &testutil.ApiServer{
+		Images: []*api.ImageJson{tagged, dangling},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	reports, err := f.ImagePrune(t.Context(), false, false, nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, reports, []api.PruneReportJson{{Id: dangling.Id, Size: 1000}})
+
+	reports, err = f.ImagePrune(t.Context(), true, false, nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, reports, []api.PruneReportJson{{Id: tagged.Id, Size: 2000}})
+}
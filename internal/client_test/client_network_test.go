@@ -9,6 +9,48 @@ import (
 	testCmp "gotest.tools/v3/assert/cmp"
 )
 
+func TestNetworkConnect(t *testing.T) {
+	n := &api.NetworkJson{
+		Id:   "1234",
+		Name: "backend",
+	}
+
+	c := &testutil.TestContainer{
+		Id:   "5678",
+		Json: api.ContainerCreateJson{Name: "web"},
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c},
+		Networks:   []*api.NetworkJson{n},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	options := api.ContainerCreateNetworkJson{
+		Aliases:   []string{"www"},
+		StaticIPs: []string{"10.89.0.10"},
+	}
+
+	err = f.NetworkConnect(t.Context(), n.Id, &api.NetworkConnectJson{
+		Container:                  c.Json.Name,
+		ContainerCreateNetworkJson: options,
+	})
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, c.Json.Networks, map[string]api.ContainerCreateNetworkJson{"backend": options})
+
+	inspect, err := f.ContainerInspect(t.Context(), c.Id)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, inspect.NetworkSettings.Networks, map[string]api.ContainerInspectNetworkJson{"backend": {}})
+
+	err = f.NetworkConnect(t.Context(), n.Name, &api.NetworkConnectJson{Container: c.Id})
+	assert.ErrorContains(t, err, "already connected")
+}
+
 func TestNetworkCreate(t *testing.T) {
 	apiServer := &testutil.ApiServer{}
 
@@ -59,6 +101,74 @@ func TestNetworkGet(t *testing.T) {
 	assert.DeepEqual(t, n2, actual)
 }
 
+func TestNetworkList(t *testing.T) {
+	n1 := &api.NetworkJson{
+		Id:   "1",
+		Name: "frontend",
+	}
+
+	n2 := &api.NetworkJson{
+		Id:     "2",
+		Labels: map[string]string{"tier": "db"},
+		Name:   "backend",
+	}
+
+	apiServer := &testutil.ApiServer{
+		Networks: []*api.NetworkJson{n1, n2},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	all, err := f.NetworkList(t.Context(), nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, all, []api.NetworkJson{*n1, *n2})
+
+	byName, err := f.NetworkList(t.Context(), api.ListFilters{"name": {"^front"}})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, byName, []api.NetworkJson{*n1})
+
+	byLabel, err := f.NetworkList(t.Context(), api.ListFilters{"label": {"tier"}})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, byLabel, []api.NetworkJson{*n2})
+}
+
+func TestNetworkPrune(t *testing.T) {
+	used := &api.NetworkJson{
+		Id:   "1",
+		Name: "used",
+	}
+
+	unused := &api.NetworkJson{
+		Id:   "2",
+		Name: "unused",
+	}
+
+	c := &testutil.TestContainer{
+		Id: "3",
+		Json: api.ContainerCreateJson{
+			Name:     "one",
+			Networks: map[string]api.ContainerCreateNetworkJson{"used": {}},
+		},
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c},
+		Networks:   []*api.NetworkJson{used, unused},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	reports, err := f.NetworkPrune(t.Context(), nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, reports, []api.NetworkPruneReportJson{{Name: unused.Name}})
+}
+
 func TestNetworkDelete(t *testing.T) {
 	n := &api.NetworkJson{
 		Id:   "1234",
@@ -80,3 +190,35 @@ func TestNetworkDelete(t *testing.T) {
 	_, err = f.NetworkInspect(t.Context(), n.Id)
 	assert.ErrorContains(t, err, "not found")
 }
+
+func TestNetworkDisconnect(t *testing.T) {
+	n := &api.NetworkJson{
+		Id:   "1234",
+		Name: "backend",
+	}
+
+	c := &testutil.TestContainer{
+		Id: "5678",
+		Json: api.ContainerCreateJson{
+			Name:     "web",
+			Networks: map[string]api.ContainerCreateNetworkJson{"1234": {}},
+		},
+	}
+
+	apiServer := &testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c},
+		Networks:   []*api.NetworkJson{n},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	err = f.NetworkDisconnect(t.Context(), n.Name, &api.NetworkDisconnectJson{Container: c.Json.Name})
+	assert.NilError(t, err)
+	assert.Equal(t, len(c.Json.Networks), 0)
+
+	err = f.NetworkDisconnect(t.Context(), n.Name, &api.NetworkDisconnectJson{Container: c.Json.Name})
+	assert.ErrorContains(t, err, "not connected")
+}
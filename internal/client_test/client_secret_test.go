@@ -60,6 +60,37 @@ func TestSecretGet(t *testing.T) {
 	assert.DeepEqual(t, s2, actual)
 }
 
+func TestSecretList(t *testing.T) {
+	s1 := &api.SecretInspectJson{
+		Id:         "1",
+		SecretData: "hunter2",
+		Spec: api.SecretInspectSpecJson{
+			Labels: map[string]string{"app": "web"},
+			Name:   "web-password",
+		},
+	}
+
+	s2 := &api.SecretInspectJson{
+		Id: "2",
+		Spec: api.SecretInspectSpecJson{
+			Name: "db-password",
+		},
+	}
+
+	apiServer := &testutil.ApiServer{
+		Secrets: []*api.SecretInspectJson{s1, s2},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	actual, err := f.SecretList(t.Context(), api.ListFilters{"label": {"app=web"}})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, actual, []api.SecretInspectJson{{Id: s1.Id, Spec: s1.Spec}})
+}
+
 func TestSecretDelete(t *testing.T) {
 	s := &api.SecretInspectJson{
 		Id: "1234",
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/testutil"
+	"gotest.tools/v3/assert"
+)
+
+func TestVolumePrune(t *testing.T) {
+	v1 := &api.VolumeJson{
+		Labels: map[string]string{"app": "web"},
+		Name:   "web-data",
+	}
+
+	v2 := &api.VolumeJson{
+		Name: "db-data",
+	}
+
+	apiServer := &testutil.ApiServer{
+		Volumes: []*api.VolumeJson{v1, v2},
+	}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	listed, err := f.VolumeList(t.Context(), api.ListFilters{"label": {"app=web"}})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, listed, []api.VolumeJson{*v1})
+
+	reports, err := f.VolumePrune(t.Context(), api.ListFilters{"label": {"app=web"}})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, reports, []api.PruneReportJson{{Id: v1.Name}})
+
+	remaining, err := f.VolumeList(t.Context(), nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, remaining, []api.VolumeJson{*v2})
+}
@@ -63,6 +63,25 @@ func TestHttpTransport(t *testing.T) {
 	assert.NilError(t, err)
 }
 
+func TestPingApiVersion(t *testing.T) {
+	apiServer := &testutil.ApiServer{ApiVersion: "4.9.3"}
+
+	f, err := spawnFramework(t.Context(), apiServer)
+	assert.NilError(t, err)
+
+	defer f.Stop(t.Context())
+
+	assert.Equal(t, f.ApiVersionKnown(), false)
+	assert.Equal(t, f.ApiVersionAtLeast(4, 0), false)
+
+	err = f.Ping(t.Context())
+	assert.NilError(t, err)
+
+	assert.Equal(t, f.ApiVersionKnown(), true)
+	assert.Equal(t, f.ApiVersionAtLeast(4, 9), true)
+	assert.Equal(t, f.ApiVersionAtLeast(5, 0), false)
+}
+
 func TestUnixTransport(t *testing.T) {
 	clientUrl, err := url.Parse("unix:///tmp/test-socket")
 	assert.NilError(t, err)
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+type actionBase struct {
+	ps *podmanProviderState
+}
+
+func (a *actionBase) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	ps, ok := req.ProviderData.(*podmanProviderState)
+
+	if !ok {
+		resp.Diagnostics.AddError("Internal error", "Invalid provider state type")
+
+		return
+	}
+
+	a.ps = ps
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newContainerRestartAction() action.Action {
+	return &containerRestartAction{}
+}
+
+type containerRestartAction struct {
+	actionBase
+}
+
+type containerRestartActionModel struct {
+	Container     types.String `tfsdk:"container"`
+	ContainerHost types.String `tfsdk:"container_host"`
+	Operation     types.String `tfsdk:"operation"`
+	Signal        types.String `tfsdk:"signal"`
+	Timeout       types.Int32  `tfsdk:"timeout"`
+	WaitFor       types.String `tfsdk:"wait_for"`
+	WaitTimeout   types.Int32  `tfsdk:"wait_timeout"`
+}
+
+func (a *containerRestartAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container_restart"
+}
+
+func (a *containerRestartAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restarts, stops, starts or signals an existing container without replacing it, e.g. after a configuration file on a bind mount has been changed. This action can be invoked from an `action_trigger` block in another resource's `lifecycle`.",
+
+		Attributes: map[string]schema.Attribute{
+			"container": schema.StringAttribute{
+				MarkdownDescription: "ID or name of the container",
+				Required:            true,
+			},
+			"container_host": schema.StringAttribute{
+				MarkdownDescription: "URL of the container host where the container resides",
+				Optional:            true,
+			},
+			"operation": schema.StringAttribute{
+				MarkdownDescription: "One of `restart`, `start`, `stop` or `kill`. Defaults to `restart`. Starting a running container or stopping a stopped container does nothing.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("restart", "start", "stop", "kill"),
+				},
+			},
+			"signal": schema.StringAttribute{
+				MarkdownDescription: "Signal to send when `operation` is `kill`, e.g. `SIGHUP` to ask a process to reload its configuration. Defaults to `SIGKILL`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(slices.Sorted(maps.Keys(linuxSignals))...),
+				},
+			},
+			"timeout": schema.Int32Attribute{
+				MarkdownDescription: "Number of seconds to wait for the container to stop before killing it, when `operation` is `restart` or `stop`. Defaults to the container's own stop timeout.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"wait_for": schema.StringAttribute{
+				MarkdownDescription: "Wait for the container to become `running` or `healthy` before the action completes. The latter requires the container to have a health check. Not valid when `operation` is `stop`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("running", "healthy"),
+				},
+			},
+			"wait_timeout": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of seconds to wait for the container to reach the `wait_for` state. Defaults to 60.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.AlsoRequires(path.MatchRoot("wait_for")),
+				},
+			},
+		},
+	}
+}
+
+func (a *containerRestartAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data containerRestartActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Operation.IsUnknown() {
+		return
+	}
+
+	operation := data.Operation.ValueString()
+
+	if !data.Signal.IsNull() && operation != "kill" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("signal"),
+			"Invalid attribute combination",
+			"signal can only be set when operation is \"kill\"")
+	}
+
+	if !data.Timeout.IsNull() && operation != "" && operation != "restart" && operation != "stop" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid attribute combination",
+			"timeout can only be set when operation is \"restart\" or \"stop\"")
+	}
+
+	if !data.WaitFor.IsNull() && operation == "stop" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for"),
+			"Invalid attribute combination",
+			"wait_for can not be set when operation is \"stop\"")
+	}
+}
+
+func (a *containerRestartAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data containerRestartActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := a.ps.getClient(ctx, data.ContainerHost.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Connection Error", err.Error())
+
+		return
+	}
+
+	nameOrId := data.Container.ValueString()
+	var timeout *int
+
+	if !data.Timeout.IsNull() {
+		t := int(data.Timeout.ValueInt32())
+		timeout = &t
+	}
+
+	switch data.Operation.ValueString() {
+	case "start":
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Starting container %s", nameOrId)})
+		err = c.ContainerStart(ctx, nameOrId)
+
+		if status, ok := err.(client.StatusCodeError); ok && status.StatusCode == http.StatusNotModified {
+			err = nil
+		}
+	case "stop":
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Stopping container %s", nameOrId)})
+		err = c.ContainerStop(ctx, nameOrId, timeout)
+	case "kill":
+		signal := "SIGKILL"
+
+		if !data.Signal.IsNull() {
+			signal = data.Signal.ValueString()
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Sending %s to container %s", signal, nameOrId)})
+		err = c.ContainerKill(ctx, nameOrId, signal)
+	default:
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Restarting container %s", nameOrId)})
+		err = c.ContainerRestart(ctx, nameOrId, timeout)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Request failed", err.Error())
+
+		return
+	}
+
+	if data.WaitFor.IsNull() {
+		return
+	}
+
+	waitTimeout := 60 * time.Second
+
+	if !data.WaitTimeout.IsNull() {
+		waitTimeout = time.Duration(data.WaitTimeout.ValueInt32()) * time.Second
+	}
+
+	state := data.WaitFor.ValueString()
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Waiting for container %s to become %s", nameOrId, state)})
+	err = waitForContainer(ctx, c, nameOrId, state == "healthy", nil, waitTimeout)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for container", err.Error()+containerFailureDetails(ctx, c, nameOrId))
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Container %s is %s", nameOrId, state)})
+}
@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Targets are pruned in this order, so that images and networks that were only used by pruned
+// containers are themselves pruned.
+var pruneTargets = []string{"containers", "images", "networks", "volumes"}
+
+// The same objects that `podman system prune` removes by default.
+var defaultPruneTargets = []string{"containers", "images", "networks"}
+
+func newSystemPruneAction() action.Action {
+	return &systemPruneAction{}
+}
+
+type systemPruneAction struct {
+	actionBase
+}
+
+type systemPruneActionModel struct {
+	AllImages     types.Bool   `tfsdk:"all_images"`
+	BuildCache    types.Bool   `tfsdk:"build_cache"`
+	ContainerHost types.String `tfsdk:"container_host"`
+	DryRun        types.Bool   `tfsdk:"dry_run"`
+	Labels        types.Map    `tfsdk:"labels"`
+	Targets       types.Set    `tfsdk:"targets"`
+	Until         types.String `tfsdk:"until"`
+}
+
+// The outcome of pruning one target. Size is zero if the amount of reclaimed space is unknown.
+// Failures describe the objects that could not be removed and are not included in the names or
+// size.
+type pruneResult struct {
+	failures []string
+	names    []string
+	size     uint64
+}
+
+func (a *systemPruneAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_prune"
+}
+
+func (a *systemPruneAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Removes unused containers, images, networks and volumes from a container host, and reports the amount of disk space that was reclaimed.",
+
+		Attributes: map[string]schema.Attribute{
+			"all_images": schema.BoolAttribute{
+				MarkdownDescription: "Remove all images that are not used by a container, not just dangling images. Defaults to false.",
+				Optional:            true,
+			},
+			"build_cache": schema.BoolAttribute{
+				MarkdownDescription: "Also remove persistent build cache mounts when pruning images. Defaults to false.",
+				Optional:            true,
+			},
+			"container_host": schema.StringAttribute{
+				MarkdownDescription: "URL of the container host to prune",
+				Optional:            true,
+			},
+			"dry_run": schema.BoolAttribute{
+				MarkdownDescription: "List the objects that would be removed without removing them. Images and networks that are only used by containers that would be removed are not listed, and build cache mounts are never listed. Defaults to false.",
+				Optional:            true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only remove objects that have all of these labels. An empty value matches any value of the label.",
+				Optional:            true,
+			},
+			"targets": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Types of object to remove: any of `containers` (stopped containers), `images`, `networks` (networks that are not used by any container) and `volumes` (volumes that are not used by any container). Defaults to `containers`, `images` and `networks`, like `podman system prune`.",
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(pruneTargets...)),
+				},
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "Only remove objects that were created before this time, given as a timestamp or as a duration relative to the current time such as `24h`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *systemPruneAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data systemPruneActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Targets.IsUnknown() {
+		return
+	}
+
+	targets := defaultPruneTargets
+
+	if !data.Targets.IsNull() {
+		resp.Diagnostics.Append(data.Targets.ElementsAs(ctx, &targets, false)...)
+	}
+
+	if slices.Contains(targets, "images") {
+		return
+	}
+
+	for name, value := range map[string]types.Bool{"all_images": data.AllImages, "build_cache": data.BuildCache} {
+		if value.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid attribute combination",
+				name+" can only be set when targets includes \"images\"")
+		}
+	}
+}
+
+func (a *systemPruneAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data systemPruneActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets := defaultPruneTargets
+
+	if !data.Targets.IsNull() {
+		resp.Diagnostics.Append(data.Targets.ElementsAs(ctx, &targets, false)...)
+	}
+
+	filters := make(api.ListFilters)
+	resp.Diagnostics.Append(addLabelFilters(ctx, filters, data.Labels)...)
+
+	if !data.Until.IsNull() {
+		filters["until"] = []string{data.Until.ValueString()}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := a.ps.getClient(ctx, data.ContainerHost.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Connection Error", err.Error())
+
+		return
+	}
+
+	var total uint64
+
+	for _, target := range pruneTargets {
+		if !slices.Contains(targets, target) {
+			continue
+		}
+
+		var result *pruneResult
+
+		if data.DryRun.ValueBool() {
+			result, err = pruneDryRun(ctx, c, target, data.AllImages.ValueBool(), filters)
+		} else {
+			result, err = prune(ctx, c, target, data.AllImages.ValueBool(), data.BuildCache.ValueBool(), filters)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Error pruning "+target, err.Error())
+
+			return
+		}
+
+		for _, failure := range result.failures {
+			resp.Diagnostics.AddWarning("Error pruning "+target, failure)
+		}
+
+		total += result.size
+		resp.SendProgress(action.InvokeProgressEvent{Message: result.describe(target, data.DryRun.ValueBool())})
+	}
+
+	if data.DryRun.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Dry run: would reclaim %s in total", formatBytes(total))})
+	} else {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Reclaimed %s in total", formatBytes(total))})
+	}
+}
+
+func prune(ctx context.Context, c *client.Client, target string, allImages bool, buildCache bool, filters api.ListFilters) (*pruneResult, error) {
+	result := &pruneResult{names: make([]string, 0)}
+	var reports []api.PruneReportJson
+	var err error
+
+	switch target {
+	case "containers":
+		reports, err = c.ContainerPrune(ctx, filters)
+	case "images":
+		reports, err = c.ImagePrune(ctx, allImages, buildCache, filters)
+	case "networks":
+		var networks []api.NetworkPruneReportJson
+		networks, err = c.NetworkPrune(ctx, filters)
+
+		for _, n := range networks {
+			result.names = append(result.names, n.Name)
+		}
+	case "volumes":
+		reports, err = c.VolumePrune(ctx, filters)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, report := range reports {
+		if report.Err != nil {
+			result.failures = append(result.failures, fmt.Sprintf("%s could not be removed: %s", report.Id, *report.Err))
+
+			continue
+		}
+
+		result.names = append(result.names, report.Id)
+		result.size += report.Size
+	}
+
+	return result, nil
+}
+
+// Libpod has no dry-run mode for pruning, so list the objects that are currently unused instead.
+func pruneDryRun(ctx context.Context, c *client.Client, target string, allImages bool, filters api.ListFilters) (*pruneResult, error) {
+	result := &pruneResult{names: make([]string, 0)}
+	listFilters := make(api.ListFilters)
+
+	for key, values := range filters {
+		listFilters[key] = values
+	}
+
+	switch target {
+	case "containers":
+		listFilters["status"] = []string{"created", "exited"}
+		containers, err := c.ContainerList(ctx, listFilters)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, container := range containers {
+			result.names = append(result.names, displayName(container.Names, container.Id))
+		}
+	case "images":
+		if allImages {
+			listFilters["containers"] = []string{"false"}
+		} else {
+			listFilters["dangling"] = []string{"true"}
+		}
+
+		images, err := c.ImageList(ctx, listFilters)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, img := range images {
+			result.names = append(result.names, displayName(img.RepoTags, img.Id))
+			result.size += uint64(img.Size)
+		}
+	case "networks":
+		listFilters["dangling"] = []string{"true"}
+		networks, err := c.NetworkList(ctx, listFilters)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, n := range networks {
+			result.names = append(result.names, n.Name)
+		}
+	case "volumes":
+		listFilters["dangling"] = []string{"true"}
+		volumes, err := c.VolumeList(ctx, listFilters)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range volumes {
+			result.names = append(result.names, v.Name)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *pruneResult) describe(target string, dryRun bool) string {
+	var sb strings.Builder
+
+	if dryRun {
+		fmt.Fprintf(&sb, "Would remove %d %s", len(r.names), target)
+	} else {
+		fmt.Fprintf(&sb, "Removed %d %s", len(r.names), target)
+	}
+
+	if r.size > 0 {
+		fmt.Fprintf(&sb, " (%s)", formatBytes(r.size))
+	}
+
+	if len(r.names) > 0 {
+		fmt.Fprintf(&sb, ": %s", strings.Join(r.names, ", "))
+	}
+
+	return sb.String()
+}
+
+func formatBytes(n uint64) string {
+	units := []string{"B", "kB", "MB", "GB", "TB"}
+	value := float64(n)
+	i := 0
+
+	for value >= 1000 && i < len(units)-1 {
+		value /= 1000
+		i++
+	}
+
+	if i == 0 {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	return fmt.Sprintf("%.1f %s", value, units[i])
+}
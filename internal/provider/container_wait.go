@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/decafcode/terraform-provider-podman/internal/client"
+)
+
+// Polls a container once a second until it is running, and also healthy if `healthy` is set and
+// has written a line matching `logPattern` to its output if that is not nil. Gives up straight
+// away if the container exits. The timeout is reported in the same way whether it expires between
+// polls or during one.
+func waitForContainer(ctx context.Context, c *client.Client, nameOrId string, healthy bool, logPattern *regexp.Regexp, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	status := "not ready"
+	running := false
+
+	timedOut := func() error {
+		if logPattern != nil && running {
+			return fmt.Errorf("container %s has not logged a line matching %q after %s", nameOrId, logPattern, timeout)
+		}
+
+		return fmt.Errorf("container %s is still %s after %s", nameOrId, status, timeout)
+	}
+
+	for {
+		json, err := c.ContainerInspect(ctx, nameOrId)
+
+		if err != nil && ctx.Err() != nil {
+			return timedOut()
+		} else if err != nil {
+			return err
+		}
+
+		status = json.State.Status
+		running = json.State.Running
+
+		if status == "exited" {
+			return fmt.Errorf("container %s exited before it became ready", nameOrId)
+		}
+
+		ready := running
+
+		if ready && healthy {
+			if json.State.Health == nil {
+				return fmt.Errorf("container %s does not have a health check", nameOrId)
+			}
+
+			ready = json.State.Health.Status == "healthy"
+		}
+
+		if ready && logPattern != nil {
+			lines, err := c.ContainerLogs(ctx, nameOrId, 0)
+
+			if err != nil && ctx.Err() != nil {
+				return timedOut()
+			} else if err != nil {
+				return err
+			}
+
+			ready = slices.ContainsFunc(lines, logPattern.MatchString)
+		}
+
+		if ready {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return timedOut()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Describes the container's most recent health checks and log output, for inclusion in error
+// messages when a container fails to start. This is best effort, so errors are ignored.
+func containerFailureDetails(ctx context.Context, c *client.Client, nameOrId string) string {
+	var sb strings.Builder
+
+	json, err := c.ContainerInspect(ctx, nameOrId)
+
+	if err == nil && json.State.Health != nil && len(json.State.Health.Log) > 0 {
+		checks := json.State.Health.Log[max(0, len(json.State.Health.Log)-5):]
+		sb.WriteString("\n\nMost recent health checks:\n")
+
+		for _, check := range checks {
+			fmt.Fprintf(&sb, "\n  exit code %d: %s", check.ExitCode, strings.TrimSpace(check.Output))
+		}
+	}
+
+	lines, err := c.ContainerLogs(ctx, nameOrId, 20)
+
+	if err == nil && len(lines) > 0 {
+		sb.WriteString("\n\nMost recent log output:\n")
+
+		for _, line := range lines {
+			sb.WriteString("\n  " + line)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func newIsDigestPinnedFunction() function.Function {
+	return &isDigestPinnedFunction{}
+}
+
+type isDigestPinnedFunction struct{}
+
+func (f *isDigestPinnedFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_digest_pinned"
+}
+
+func (f *isDigestPinnedFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether an image reference is pinned to a digest",
+		MarkdownDescription: "Returns true if the given image reference includes a digest (e.g. `@sha256:...`) and false otherwise. Raises an error if the reference is not valid.\n\n" +
+			"This is intended for use in variable validation blocks and preconditions, so that modules can insist on references that are protected against a registry serving different content under the same tag.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "reference",
+				MarkdownDescription: "Image reference to check",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *isDigestPinnedFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var reference string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &reference))
+
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := parseImageReference(reference)
+
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, parsed.Digest != ""))
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newParseImageReferenceFunction() function.Function {
+	return &parseImageReferenceFunction{}
+}
+
+type parseImageReferenceFunction struct{}
+
+type parseImageReferenceModel struct {
+	Digest     types.String `tfsdk:"digest"`
+	Name       types.String `tfsdk:"name"`
+	Normalized types.String `tfsdk:"normalized"`
+	Registry   types.String `tfsdk:"registry"`
+	Repository types.String `tfsdk:"repository"`
+	Tag        types.String `tfsdk:"tag"`
+}
+
+func (f *parseImageReferenceFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_image_reference"
+}
+
+func (f *parseImageReferenceFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split an image reference into its components",
+		MarkdownDescription: "Parses an image reference using the same normalization rules as Podman and returns an object with the following attributes:\n\n" +
+			"- `registry`: Registry host name and optional port. References without a registry component (\"short names\") are assumed to refer to `docker.io`.\n" +
+			"- `repository`: Repository path within the registry. Single-component Docker Hub repositories receive a `library/` prefix, so `postgres` becomes `library/postgres`.\n" +
+			"- `tag`: Image tag. This is `latest` if the reference specifies neither a tag nor a digest, and null if the reference specifies a digest but no tag.\n" +
+			"- `digest`: Image digest such as `sha256:...`, or null if the reference is not pinned to a digest.\n" +
+			"- `name`: The `registry` and `repository` joined by a slash.\n" +
+			"- `normalized`: The fully qualified form of the reference.\n\n" +
+			"Note that Podman hosts may be configured to search registries other than `docker.io` for short names (see `unqualified-search-registries` in `registries.conf`), in which case you should use fully qualified references anyway.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "reference",
+				MarkdownDescription: "Image reference to parse, e.g. `docker.io/library/postgres:17.5`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"digest":     types.StringType,
+				"name":       types.StringType,
+				"normalized": types.StringType,
+				"registry":   types.StringType,
+				"repository": types.StringType,
+				"tag":        types.StringType,
+			},
+		},
+	}
+}
+
+func (f *parseImageReferenceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var reference string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &reference))
+
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := parseImageReference(reference)
+
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	result := parseImageReferenceModel{
+		Digest:     types.StringNull(),
+		Name:       types.StringValue(parsed.Name()),
+		Normalized: types.StringValue(parsed.String()),
+		Registry:   types.StringValue(parsed.Registry),
+		Repository: types.StringValue(parsed.Repository),
+		Tag:        types.StringNull(),
+	}
+
+	if parsed.Digest != "" {
+		result.Digest = types.StringValue(parsed.Digest)
+	}
+
+	if parsed.Tag != "" {
+		result.Tag = types.StringValue(parsed.Tag)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, &result))
+}
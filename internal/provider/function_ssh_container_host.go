@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+)
+
+func newSshContainerHostFunction() function.Function {
+	return &sshContainerHostFunction{}
+}
+
+type sshContainerHostFunction struct{}
+
+func (f *sshContainerHostFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ssh_container_host"
+}
+
+func (f *sshContainerHostFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an ssh:// container_host URL",
+		MarkdownDescription: "Builds an `ssh://` URL suitable for use as a `container_host` attribute, with the host's public key correctly escaped into the `#pubkey=...` or `#ca=...` URL fragment (see the [SSH hosts](../index.md#ssh-hosts) section of the provider documentation).\n\n" +
+			"The `host_key` argument is a single line in OpenSSH `authorized_keys` format, such as the contents of a host's `/etc/ssh/ssh_host_ed25519_key.pub` file. If the line starts with the `cert-authority` option then the key is treated as the public key of an SSH certificate authority and a `#ca=...` fragment is produced, otherwise a `#pubkey=...` fragment is produced.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "user",
+				MarkdownDescription: "User name to log in as",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.StringParameter{
+				Name:                "host",
+				MarkdownDescription: "Host name or IP address of the container host",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			function.Int32Parameter{
+				AllowNullValue:      true,
+				Name:                "port",
+				MarkdownDescription: "SSH port, or null to use the default port 22",
+				Validators: []function.Int32ParameterValidator{
+					int32validator.Between(1, 65535),
+				},
+			},
+			function.StringParameter{
+				Name:                "socket_path",
+				MarkdownDescription: "Absolute path of the Podman API socket on the container host, e.g. `/run/podman/podman.sock`",
+			},
+			function.StringParameter{
+				Name:                "host_key",
+				MarkdownDescription: "Public key of the container host, or of a certificate authority that signs the container host's certificates, in `authorized_keys` format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *sshContainerHostFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var user, host, socketPath, hostKey string
+	var port types.Int32
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &user, &host, &port, &socketPath, &hostKey))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if !strings.HasPrefix(socketPath, "/") {
+		resp.Error = function.NewArgumentFuncError(3, "socket_path must be an absolute path")
+
+		return
+	}
+
+	pub, _, options, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(4, fmt.Sprintf("unable to parse host_key: %v", err))
+
+		return
+	}
+
+	param := "pubkey"
+
+	if slices.Contains(options, "cert-authority") {
+		param = "ca"
+	}
+
+	fragment := make(url.Values)
+	fragment.Add(param, pub.Type()+" "+base64.StdEncoding.EncodeToString(pub.Marshal()))
+
+	u := url.URL{
+		Scheme: "ssh",
+		User:   url.User(user),
+		Path:   socketPath,
+	}
+
+	if !port.IsNull() {
+		u.Host = net.JoinHostPort(host, strconv.Itoa(int(port.ValueInt32())))
+	} else if strings.Contains(host, ":") {
+		u.Host = "[" + host + "]"
+	} else {
+		u.Host = host
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, u.String()+"#"+fragment.Encode()))
+}
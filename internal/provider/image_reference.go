@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Grammar taken from the distribution/reference package, which is what Podman uses to normalize
+// image references. Short names (those without a registry component) are qualified with
+// docker.io, and single-component Docker Hub repositories get a `library/` prefix.
+
+const (
+	defaultImageRegistry = "docker.io"
+	defaultImageTag      = "latest"
+	maxImageNameLength   = 255
+)
+
+var (
+	imageDigestRegexp    = regexp.MustCompile(`^[a-z0-9]+(?:[.+_-][a-z0-9]+)*:[a-zA-Z0-9=_-]+$`)
+	imagePathRegexp      = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	imageRegistryRegexp  = regexp.MustCompile(`^(?:(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])(?:\.(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]))*|\[[a-fA-F0-9:]+\])(?::[0-9]+)?$`)
+	imageSha256Regexp    = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+	imageTagRegexp       = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+	imageUppercaseRegexp = regexp.MustCompile(`[A-Z]`)
+)
+
+type imageReference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+func parseImageReference(ref string) (*imageReference, error) {
+	result := &imageReference{}
+	name := ref
+
+	if pos := strings.Index(name, "@"); pos != -1 {
+		result.Digest = name[pos+1:]
+		name = name[:pos]
+
+		if !imageDigestRegexp.MatchString(result.Digest) {
+			return nil, fmt.Errorf("invalid digest \"%s\" in image reference \"%s\"", result.Digest, ref)
+		}
+
+		if strings.HasPrefix(result.Digest, "sha256:") && !imageSha256Regexp.MatchString(result.Digest) {
+			return nil, fmt.Errorf("invalid sha256 digest \"%s\" in image reference \"%s\"", result.Digest, ref)
+		}
+	}
+
+	if pos := strings.LastIndex(name, ":"); pos != -1 && !strings.Contains(name[pos:], "/") {
+		result.Tag = name[pos+1:]
+		name = name[:pos]
+
+		if !imageTagRegexp.MatchString(result.Tag) {
+			return nil, fmt.Errorf("invalid tag \"%s\" in image reference \"%s\"", result.Tag, ref)
+		}
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("image reference \"%s\" does not contain a repository name", ref)
+	}
+
+	pos := strings.Index(name, "/")
+
+	if pos != -1 && isImageRegistry(name[:pos]) {
+		result.Registry = name[:pos]
+		result.Repository = name[pos+1:]
+
+		if !imageRegistryRegexp.MatchString(result.Registry) {
+			return nil, fmt.Errorf("invalid registry \"%s\" in image reference \"%s\"", result.Registry, ref)
+		}
+	} else {
+		result.Registry = defaultImageRegistry
+		result.Repository = name
+	}
+
+	if result.Registry == "index.docker.io" {
+		result.Registry = defaultImageRegistry
+	}
+
+	if result.Registry == defaultImageRegistry && !strings.Contains(result.Repository, "/") {
+		result.Repository = "library/" + result.Repository
+	}
+
+	if !imagePathRegexp.MatchString(result.Repository) {
+		return nil, fmt.Errorf("invalid repository name \"%s\" in image reference \"%s\"", result.Repository, ref)
+	}
+
+	if len(result.Name()) > maxImageNameLength {
+		return nil, fmt.Errorf("repository name in image reference \"%s\" is longer than %d characters", ref, maxImageNameLength)
+	}
+
+	if result.Tag == "" && result.Digest == "" {
+		result.Tag = defaultImageTag
+	}
+
+	return result, nil
+}
+
+// The first component of a reference is a registry if it looks like a hostname, i.e. it contains
+// a dot or a port number, or it is "localhost", or it contains uppercase letters (which are not
+// allowed in repository names).
+func isImageRegistry(component string) bool {
+	return strings.ContainsAny(component, ".:") ||
+		component == "localhost" ||
+		imageUppercaseRegexp.MatchString(component)
+}
+
+// Registry and repository, without any tag or digest.
+func (r *imageReference) Name() string {
+	return r.Registry + "/" + r.Repository
+}
+
+// Fully qualified form of this reference.
+func (r *imageReference) String() string {
+	result := r.Name()
+
+	if r.Tag != "" {
+		result += ":" + r.Tag
+	}
+
+	if r.Digest != "" {
+		result += "@" + r.Digest
+	}
+
+	return result
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Support for `moved` blocks whose source is a resource managed by the kreuzwerker/docker
+// provider. The Docker provider is built on the legacy plugin SDK, so we decode its raw JSON state
+// directly instead of declaring a source schema: this keeps us tolerant of attributes that are
+// added to or removed from that provider over time.
+
+// Only the resource type name is checked, not the source provider address, since the address
+// depends on where the Docker provider was installed from (e.g. a private registry mirror).
+func decodeDockerState(req resource.MoveStateRequest, typeName string, out any) (map[string]json.RawMessage, diag.Diagnostics) {
+	var result diag.Diagnostics
+
+	if req.SourceTypeName != typeName || req.SourceRawState == nil {
+		return nil, result
+	}
+
+	raw := make(map[string]json.RawMessage)
+	err := json.Unmarshal(req.SourceRawState.JSON, &raw)
+
+	if err == nil {
+		err = json.Unmarshal(req.SourceRawState.JSON, out)
+	}
+
+	if err != nil {
+		result.AddError(
+			"Unable to decode Docker provider state",
+			fmt.Sprintf("The state of the %s resource could not be decoded: %s", typeName, err.Error()))
+
+		return nil, result
+	}
+
+	return raw, result
+}
+
+// Returns the names of any of the given attributes that have a non-empty value in the source
+// state. These are attributes that this provider has no equivalent for.
+func dockerUntranslated(raw map[string]json.RawMessage, names ...string) []string {
+	result := make([]string, 0)
+
+	for _, name := range names {
+		value, ok := raw[name]
+
+		if ok && !isEmptyJson(value) {
+			result = append(result, name)
+		}
+	}
+
+	return result
+}
+
+func isEmptyJson(value json.RawMessage) bool {
+	switch string(bytes.TrimSpace(value)) {
+	case "", "null", "false", "0", `""`, "[]", "{}":
+		return true
+	default:
+		return false
+	}
+}
+
+func warnUntranslated(typeName string, names []string) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if len(names) == 0 {
+		return result
+	}
+
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	result.AddWarning(
+		"Some Docker provider attributes were not migrated",
+		fmt.Sprintf("The following attributes of the %s resource have no equivalent in this provider "+
+			"and were dropped from the migrated state: %s. Review the plan carefully, since the "+
+			"corresponding settings will be lost if this resource is replaced.",
+			typeName,
+			strings.Join(names, ", ")))
+
+	return result
+}
+
+// Docker image IDs carry an algorithm prefix, Podman image IDs do not.
+func trimDockerImageId(id string) string {
+	return strings.TrimPrefix(id, "sha256:")
+}
@@ -3,7 +3,10 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +21,7 @@ type podmanProvider struct {
 type podmanProviderModel struct {
 	ContainerHost     types.String `tfsdk:"container_host"`
 	HostKeyAlgorithms types.List   `tfsdk:"host_key_algorithms"`
+	SkipPlanChecks    types.Bool   `tfsdk:"skip_plan_checks"`
 }
 
 func New(version string, env *PodmanProviderEnv) func() provider.Provider {
@@ -29,6 +33,13 @@ func New(version string, env *PodmanProviderEnv) func() provider.Provider {
 	}
 }
 
+func (p *podmanProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		newContainerRestartAction,
+		newSystemPruneAction,
+	}
+}
+
 func (p *podmanProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data podmanProviderModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -65,6 +76,10 @@ func (p *podmanProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	state.SkipPlanChecks = data.SkipPlanChecks.ValueBool()
+
+	resp.ActionData = state
+	resp.ListResourceData = state
 	resp.ResourceData = state
 }
 
@@ -72,6 +87,24 @@ func (p *podmanProvider) DataSources(ctx context.Context) []func() datasource.Da
 	return []func() datasource.DataSource{}
 }
 
+func (p *podmanProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		newIsDigestPinnedFunction,
+		newParseImageReferenceFunction,
+		newSshContainerHostFunction,
+	}
+}
+
+func (p *podmanProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		newContainerListResource,
+		newImageListResource,
+		newNetworkListResource,
+		newNetworkAttachmentListResource,
+		newSecretListResource,
+	}
+}
+
 func (p *podmanProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "podman"
 	resp.Version = p.version
@@ -82,6 +115,7 @@ func (p *podmanProvider) Resources(ctx context.Context) []func() resource.Resour
 		newContainerResource,
 		newImageResource,
 		newNetworkResource,
+		newNetworkAttachmentResource,
 		newSecretResource,
 	}
 }
@@ -100,6 +134,12 @@ func (p *podmanProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 					"  The `crypto/ssh` module seems to start negotiations by requesting host keys based on NIST elliptic curves by default, so you might want to specify `[\"ssh-ed25519\"]` here to force the use of the less-dubious Ed25519 algorithm instead.",
 				Optional: true,
 			},
+			"skip_plan_checks": schema.BoolAttribute{
+				MarkdownDescription: "When true, do not contact the container host while planning. By default the provider checks that the image, networks and secrets referenced by a `podman_container` exist and that its host ports are not already bound by another container, so that these mistakes are reported during `terraform plan` rather than partway through `terraform apply`. Missing images, networks and secrets are reported as warnings, since they may be created elsewhere in the same plan.\n\n" +
+					"  Set this to true if you need to produce plans without access to the container host. If the container host cannot be reached and this attribute is not set then the checks are skipped and a warning is raised instead.\n\n" +
+					"  This does not affect changes to a container's `restart_policy` or `restart_retries`, which still contact the container host to find out whether it runs a Podman version that can update them in place, or whether the container has to be replaced.",
+				Optional: true,
+			},
 		},
 	}
 }
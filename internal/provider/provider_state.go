@@ -22,6 +22,7 @@ type PodmanProviderEnv struct {
 type podmanProviderState struct {
 	DefaultHost       string
 	HostKeyAlgorithms []string
+	SkipPlanChecks    bool
 
 	mutex    sync.Mutex
 	hosts    map[string]*client.Client
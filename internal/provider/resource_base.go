@@ -2,16 +2,27 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"strings"
 
+	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type resourceBase struct {
 	ps *podmanProviderState
 }
 
+type resourceIdentityModel struct {
+	ContainerHost types.String `tfsdk:"container_host"`
+	Id            types.String `tfsdk:"id"`
+}
+
 func (r *resourceBase) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -28,7 +39,49 @@ func (r *resourceBase) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.ps = ps
 }
 
+// All resources are identified by their ID and the container host they reside on. An import
+// may supply a name instead of an ID, in which case Read replaces it with the canonical ID.
+func (r *resourceBase) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"container_host": identityschema.StringAttribute{
+				Description:       "URL of the container host where this resource resides",
+				OptionalForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "ID or name of this resource on the container host",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String, containerHost types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, resourceIdentityModel{
+		ContainerHost: containerHost,
+		Id:            id,
+	})
+}
+
 func importState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity resourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("container_host"), identity.ContainerHost)...)
+
+		return
+	}
+
 	pos := strings.Index(req.ID, ",")
 
 	if pos != -1 {
@@ -40,3 +93,9 @@ func importState(ctx context.Context, req resource.ImportStateRequest, resp *res
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 	}
 }
+
+func isNotFound(err error) bool {
+	status, ok := err.(client.StatusCodeError)
+
+	return ok && status.StatusCode == http.StatusNotFound
+}
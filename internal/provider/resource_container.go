@@ -16,10 +16,20 @@ type containerResource struct {
 	resourceBase
 }
 
+type containerResourceAutoUpdateModel struct {
+	Authfile types.String `tfsdk:"authfile"`
+	Policy   types.String `tfsdk:"policy"`
+}
+
 type containerResourceDeviceModel struct {
 	Path types.String `tfsdk:"path"`
 }
 
+type containerResourceExtraHostModel struct {
+	Hostname types.String      `tfsdk:"hostname"`
+	Ip       iptypes.IPAddress `tfsdk:"ip"`
+}
+
 type containerResourceHealthModel struct {
 	Check         types.Object `tfsdk:"check"`
 	Interval      types.Number `tfsdk:"interval"`
@@ -35,11 +45,24 @@ type containerResourceHealthCheckModel struct {
 	ShellCommand types.String `tfsdk:"shell_command"`
 }
 
+type containerResourceLogOptionsModel struct {
+	MaxSize types.Int64  `tfsdk:"max_size"`
+	Path    types.String `tfsdk:"path"`
+	Tag     types.String `tfsdk:"tag"`
+}
+
 type containerResourceMountModel struct {
-	Target  types.String `tfsdk:"target"`
-	Options types.List   `tfsdk:"options"`
-	Source  types.String `tfsdk:"source"`
-	Type    types.String `tfsdk:"type"`
+	Chown       types.Bool   `tfsdk:"chown"`
+	Idmap       types.Bool   `tfsdk:"idmap"`
+	Mode        types.Int32  `tfsdk:"mode"`
+	Options     types.List   `tfsdk:"options"`
+	Propagation types.String `tfsdk:"propagation"`
+	ReadOnly    types.Bool   `tfsdk:"read_only"`
+	Size        types.Int64  `tfsdk:"size"`
+	Source      types.String `tfsdk:"source"`
+	Subpath     types.String `tfsdk:"subpath"`
+	Target      types.String `tfsdk:"target"`
+	Type        types.String `tfsdk:"type"`
 }
 
 type containerResourceNamespaceModel struct {
@@ -48,7 +71,12 @@ type containerResourceNamespaceModel struct {
 }
 
 type containerResourceNetworkModel struct {
-	Id types.String `tfsdk:"id"`
+	Aliases       types.List          `tfsdk:"aliases"`
+	Id            types.String        `tfsdk:"id"`
+	InterfaceName types.String        `tfsdk:"interface_name"`
+	Ipv4Address   iptypes.IPv4Address `tfsdk:"ipv4_address"`
+	Ipv6Address   iptypes.IPv6Address `tfsdk:"ipv6_address"`
+	MacAddress    types.String        `tfsdk:"mac_address"`
 }
 
 type containerResourcePortMappingModel struct {
@@ -56,6 +84,27 @@ type containerResourcePortMappingModel struct {
 	HostIP        iptypes.IPAddress `tfsdk:"host_ip"`
 	HostPort      types.Int32       `tfsdk:"host_port"`
 	Protocols     types.List        `tfsdk:"protocols"`
+	Range         types.Int32       `tfsdk:"range"`
+}
+
+type containerResourceJobModel struct {
+	AllowFailure  types.Bool  `tfsdk:"allow_failure"`
+	CaptureOutput types.Bool  `tfsdk:"capture_output"`
+	Timeout       types.Int32 `tfsdk:"timeout"`
+	Triggers      types.Map   `tfsdk:"triggers"`
+}
+
+type containerResourceLimitsModel struct {
+	BlkioWeight       types.Int64   `tfsdk:"blkio_weight"`
+	CpuShares         types.Int64   `tfsdk:"cpu_shares"`
+	Cpus              types.Float64 `tfsdk:"cpus"`
+	CpusetCpus        types.String  `tfsdk:"cpuset_cpus"`
+	CpusetMems        types.String  `tfsdk:"cpuset_mems"`
+	Memory            types.Int64   `tfsdk:"memory"`
+	MemoryReservation types.Int64   `tfsdk:"memory_reservation"`
+	MemorySwap        types.Int64   `tfsdk:"memory_swap"`
+	PidsLimit         types.Int64   `tfsdk:"pids_limit"`
+	ShmSize           types.Int64   `tfsdk:"shm_size"`
 }
 
 type containerResourceSecretModel struct {
@@ -66,6 +115,25 @@ type containerResourceSecretModel struct {
 	Uid    types.Int32  `tfsdk:"uid"`
 }
 
+type containerResourceSecurityModel struct {
+	ApparmorProfile    types.String `tfsdk:"apparmor_profile"`
+	CapAdd             types.List   `tfsdk:"cap_add"`
+	CapDrop            types.List   `tfsdk:"cap_drop"`
+	MaskedPaths        types.List   `tfsdk:"masked_paths"`
+	NoNewPrivileges    types.Bool   `tfsdk:"no_new_privileges"`
+	Privileged         types.Bool   `tfsdk:"privileged"`
+	ReadOnlyRootfs     types.Bool   `tfsdk:"read_only_rootfs"`
+	ReadOnlyTmpfs      types.Bool   `tfsdk:"read_only_tmpfs"`
+	SeccompProfilePath types.String `tfsdk:"seccomp_profile_path"`
+	UnmaskedPaths      types.List   `tfsdk:"unmasked_paths"`
+}
+
+type containerResourceUlimitModel struct {
+	Hard types.Int64  `tfsdk:"hard"`
+	Name types.String `tfsdk:"name"`
+	Soft types.Int64  `tfsdk:"soft"`
+}
+
 type containerResourceUserModel struct {
 	Group types.String `tfsdk:"group"`
 	User  types.String `tfsdk:"user"`
@@ -82,28 +150,66 @@ type containerResourceUploadModel struct {
 }
 
 type containerResourceModel struct {
-	Command          types.List   `tfsdk:"command"`
-	ContainerHost    types.String `tfsdk:"container_host"`
-	Devices          types.List   `tfsdk:"devices"`
-	Entrypoint       types.List   `tfsdk:"entrypoint"`
-	Env              types.Map    `tfsdk:"env"`
-	Health           types.Object `tfsdk:"health"`
-	Id               types.String `tfsdk:"id"`
-	Image            types.String `tfsdk:"image"`
-	Labels           types.Map    `tfsdk:"labels"`
-	Mounts           types.List   `tfsdk:"mounts"`
-	Name             types.String `tfsdk:"name"`
-	NetworkNamespace types.Object `tfsdk:"network_namespace"`
-	Networks         types.List   `tfsdk:"networks"`
-	PortMappings     types.List   `tfsdk:"port_mappings"`
-	RestartPolicy    types.String `tfsdk:"restart_policy"`
-	Secrets          types.List   `tfsdk:"secrets"`
-	SecretEnv        types.Map    `tfsdk:"secret_env"`
-	SelinuxOptions   types.List   `tfsdk:"selinux_options"`
-	StartImmediately types.Bool   `tfsdk:"start_immediately"`
-	Uploads          types.List   `tfsdk:"uploads"`
-	User             types.Object `tfsdk:"user"`
-	UserNamespace    types.Object `tfsdk:"user_namespace"`
+	AssignedHostPorts types.List   `tfsdk:"assigned_host_ports"`
+	AutoUpdate        types.Object `tfsdk:"auto_update"`
+	BlueGreen         types.Bool   `tfsdk:"blue_green"`
+	Command           types.List   `tfsdk:"command"`
+	ContainerHost     types.String `tfsdk:"container_host"`
+	DesiredState      types.String `tfsdk:"desired_state"`
+	Devices           types.List   `tfsdk:"devices"`
+	DnsOptions        types.List   `tfsdk:"dns_options"`
+	DnsSearch         types.List   `tfsdk:"dns_search"`
+	DnsServers        types.List   `tfsdk:"dns_servers"`
+	Entrypoint        types.List   `tfsdk:"entrypoint"`
+	Env               types.Map    `tfsdk:"env"`
+	EnvHost           types.Bool   `tfsdk:"env_host"`
+	ExitCode          types.Int64  `tfsdk:"exit_code"`
+	ExtraHosts        types.List   `tfsdk:"extra_hosts"`
+	Health            types.Object `tfsdk:"health"`
+	Hostname          types.String `tfsdk:"hostname"`
+	Id                types.String `tfsdk:"id"`
+	Image             types.String `tfsdk:"image"`
+	Init              types.Bool   `tfsdk:"init"`
+	InitPath          types.String `tfsdk:"init_path"`
+	Job               types.Object `tfsdk:"job"`
+	Labels            types.Map    `tfsdk:"labels"`
+	LogDriver         types.String `tfsdk:"log_driver"`
+	LogOptions        types.Object `tfsdk:"log_options"`
+	Mounts            types.List   `tfsdk:"mounts"`
+	Name              types.String `tfsdk:"name"`
+	NetworkNamespace  types.Object `tfsdk:"network_namespace"`
+	Networks          types.List   `tfsdk:"networks"`
+	PortMappings      types.List   `tfsdk:"port_mappings"`
+	RemoveVolumes     types.Bool   `tfsdk:"remove_volumes"`
+	Resources         types.Object `tfsdk:"resources"`
+	RestartPolicy     types.String `tfsdk:"restart_policy"`
+	RestartRetries    types.Int32  `tfsdk:"restart_retries"`
+	Secrets           types.List   `tfsdk:"secrets"`
+	SecretEnv         types.Map    `tfsdk:"secret_env"`
+	Security          types.Object `tfsdk:"security"`
+	SelinuxOptions    types.List   `tfsdk:"selinux_options"`
+	StartImmediately  types.Bool   `tfsdk:"start_immediately"`
+	Stderr            types.String `tfsdk:"stderr"`
+	Stdout            types.String `tfsdk:"stdout"`
+	StopSignal        types.String `tfsdk:"stop_signal"`
+	StopTimeout       types.Int32  `tfsdk:"stop_timeout"`
+	Sysctls           types.Map    `tfsdk:"sysctls"`
+	Timezone          types.String `tfsdk:"timezone"`
+	Ulimits           types.List   `tfsdk:"ulimits"`
+	Umask             types.String `tfsdk:"umask"`
+	Unsetenv          types.List   `tfsdk:"unsetenv"`
+	Uploads           types.List   `tfsdk:"uploads"`
+	User              types.Object `tfsdk:"user"`
+	UserNamespace     types.Object `tfsdk:"user_namespace"`
+	VolumesFrom       types.List   `tfsdk:"volumes_from"`
+	WaitFor           types.Object `tfsdk:"wait_for"`
+	WorkingDir        types.String `tfsdk:"working_dir"`
+}
+
+type containerResourceWaitForModel struct {
+	LogPattern types.String `tfsdk:"log_pattern"`
+	State      types.String `tfsdk:"state"`
+	Timeout    types.Int32  `tfsdk:"timeout"`
 }
 
 type containerResourceUploadKey struct {
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Name suffixes given to the new container while it is being checked, and to the old container
+// once it has been replaced.
+const blueGreenNextSuffix = "-next"
+const blueGreenPreviousSuffix = "-previous"
+
+// Label that marks a container as having been created by a `blue_green` resource, whose value is
+// the name that the container holds once it is running. The create step of a replacement has no
+// access to the prior state, so this is how it tells the container it is replacing apart from an
+// unrelated container that happens to have the same name. Containers created before `blue_green`
+// was turned on don't have the label, and have to be renamed by hand before they are replaced.
+const blueGreenLabel = "io.github.decafcode.terraform-provider-podman.blue-green"
+
+// Returned when the name that a `blue_green` container is about to take over is held by a
+// container that this provider did not create for it.
+type blueGreenConflictError struct {
+	name string
+}
+
+func (e blueGreenConflictError) Error() string {
+	return fmt.Sprintf("A container named \"%[1]s\" already exists, but it was not created by a blue_green "+
+		"podman_container with this name, so it will not be replaced. Rename or remove it first. If it was "+
+		"created before blue_green was turned on, rename it to e.g. \"%[1]s-previous\" and apply again.", e.name)
+}
+
+// Returns the ID of the container that currently holds the name that a `blue_green` container is
+// about to take over, or an empty string if the name is free. This is the container that is being
+// replaced when `create_before_destroy` is in effect.
+func blueGreenPrevious(ctx context.Context, c *client.Client, name string) (string, error) {
+	json, err := c.ContainerInspect(ctx, name)
+
+	if isNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	if json.Config.Labels[blueGreenLabel] != name {
+		return "", blueGreenConflictError{name: name}
+	}
+
+	return json.Id, nil
+}
+
+// Stops the previous container and moves its name over to the next one, which must already be
+// running. The previous container is renamed out of the way instead of being deleted so that
+// Terraform can delete it as usual, and it is restored if any step fails.
+func blueGreenSwap(ctx context.Context, c *client.Client, previousId string, nextId string, name string) error {
+	tflog.Trace(ctx, "Swapping containers", map[string]any{
+		"name":     name,
+		"previous": previousId,
+		"next":     nextId,
+	})
+
+	err := c.ContainerStop(ctx, previousId, nil)
+
+	if err != nil {
+		return err
+	}
+
+	err = c.ContainerRename(ctx, previousId, name+blueGreenPreviousSuffix)
+
+	if err != nil {
+		return errors.Join(err, c.ContainerStart(ctx, previousId))
+	}
+
+	err = c.ContainerRename(ctx, nextId, name)
+
+	if err != nil {
+		return errors.Join(
+			err,
+			c.ContainerRename(ctx, previousId, name),
+			c.ContainerStart(ctx, previousId))
+	}
+
+	return nil
+}
+
+// Discards a new container that failed to start or to pass its health check, leaving the
+// previous container untouched.
+func blueGreenRollback(ctx context.Context, c *client.Client, nextId string) error {
+	tflog.Trace(ctx, "Rolling back container replacement", map[string]any{"id": nextId})
+
+	err := c.ContainerStop(ctx, nextId, nil)
+
+	if err != nil {
+		return err
+	}
+
+	return c.ContainerDelete(ctx, nextId, true)
+}
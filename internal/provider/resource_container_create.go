@@ -4,6 +4,7 @@ import (
 	"archive/tar"
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 	"slices"
 	"strings"
@@ -18,6 +19,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const autoUpdateLabel = "io.containers.autoupdate"
+const autoUpdateAuthfileLabel = "io.containers.autoupdate.authfile"
+
 func (co *containerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data containerResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -29,30 +33,51 @@ func (co *containerResource) Create(ctx context.Context, req resource.CreateRequ
 	in := api.ContainerCreateJson{
 		Command:       make([]string, 0),
 		Env:           make(map[string]string, 0),
+		EnvHost:       data.EnvHost.ValueBoolPointer(),
+		Hostname:      data.Hostname.ValueString(),
 		Image:         data.Image.ValueString(),
+		Init:          data.Init.ValueBoolPointer(),
+		InitPath:      data.InitPath.ValueString(),
 		Name:          data.Name.ValueString(),
 		Networks:      make(map[string]api.ContainerCreateNetworkJson, 0),
 		RestartPolicy: data.RestartPolicy.ValueString(),
 		Secrets:       make([]api.ContainerCreateSecretJson, 0),
 		SecretEnv:     make(map[string]string, 0),
 		SelinuxOpts:   make([]string, 0),
+		Timezone:      data.Timezone.ValueString(),
+		Umask:         data.Umask.ValueString(),
+		WorkDir:       data.WorkingDir.ValueString(),
 	}
 
 	resp.Diagnostics.Append(data.Command.ElementsAs(ctx, &in.Command, false)...)
 	resp.Diagnostics.Append(writeDevices(ctx, &data.Devices, &in.Devices)...)
+	resp.Diagnostics.Append(data.DnsOptions.ElementsAs(ctx, &in.DnsOption, false)...)
+	resp.Diagnostics.Append(data.DnsSearch.ElementsAs(ctx, &in.DnsSearch, false)...)
+	resp.Diagnostics.Append(data.DnsServers.ElementsAs(ctx, &in.DnsServer, false)...)
 	resp.Diagnostics.Append(data.Entrypoint.ElementsAs(ctx, &in.Entrypoint, false)...)
 	resp.Diagnostics.Append(data.Env.ElementsAs(ctx, &in.Env, false)...)
+	resp.Diagnostics.Append(writeExtraHosts(ctx, &data.ExtraHosts, &in.HostAdd)...)
 	resp.Diagnostics.Append(writeHealth(ctx, &data.Health, &in.HealthConfig)...)
 	resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &in.Labels, false)...)
-	resp.Diagnostics.Append(writeMounts(ctx, &data.Mounts, &in.Mounts)...)
+	resp.Diagnostics.Append(writeAutoUpdate(ctx, &data.AutoUpdate, &in.Labels)...)
+	resp.Diagnostics.Append(writeLogging(ctx, &data.LogDriver, &data.LogOptions, &in.LogConfiguration)...)
+	resp.Diagnostics.Append(writeMounts(ctx, &data.Mounts, &in)...)
 	resp.Diagnostics.Append(writeNamespace(ctx, &data.NetworkNamespace, &in.Netns)...)
 	resp.Diagnostics.Append(writeNetworks(ctx, &data.Networks, &in.Networks)...)
 	resp.Diagnostics.Append(writePortMappings(ctx, &data.PortMappings, &in.PortMappings)...)
+	resp.Diagnostics.Append(writeResources(ctx, &data.Resources, &in.ResourceLimits, &in.ShmSize)...)
+	resp.Diagnostics.Append(writeRestartRetries(&data.RestartRetries, &in.RestartTries)...)
+	resp.Diagnostics.Append(writeStop(&data.StopSignal, &data.StopTimeout, &in)...)
+	resp.Diagnostics.Append(data.Sysctls.ElementsAs(ctx, &in.Sysctl, false)...)
+	resp.Diagnostics.Append(writeUlimits(ctx, &data.Ulimits, &in.RLimits)...)
+	resp.Diagnostics.Append(data.Unsetenv.ElementsAs(ctx, &in.Unsetenv, false)...)
 	resp.Diagnostics.Append(writeSecrets(ctx, &data.Secrets, &in.Secrets)...)
 	resp.Diagnostics.Append(data.SecretEnv.ElementsAs(ctx, &in.SecretEnv, false)...)
+	resp.Diagnostics.Append(writeSecurity(ctx, &data.Security, &in)...)
 	resp.Diagnostics.Append(data.SelinuxOptions.ElementsAs(ctx, &in.SelinuxOpts, false)...)
 	resp.Diagnostics.Append(writeUser(ctx, &data.User, &in.User)...)
 	resp.Diagnostics.Append(writeNamespace(ctx, &data.UserNamespace, &in.Userns)...)
+	resp.Diagnostics.Append(data.VolumesFrom.ElementsAs(ctx, &in.VolumesFrom, false)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -66,6 +91,33 @@ func (co *containerResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	var previousId string
+
+	if data.BlueGreen.ValueBool() {
+		previousId, err = blueGreenPrevious(ctx, c, in.Name)
+		_, conflict := err.(blueGreenConflictError)
+
+		if conflict {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Container name conflict", err.Error())
+
+			return
+		} else if err != nil {
+			resp.Diagnostics.AddError("Error inspecting container", err.Error())
+
+			return
+		}
+
+		if in.Labels == nil {
+			in.Labels = make(map[string]string)
+		}
+
+		in.Labels[blueGreenLabel] = in.Name
+
+		if previousId != "" {
+			in.Name += blueGreenNextSuffix
+		}
+	}
+
 	out, err := c.ContainerCreate(ctx, &in)
 
 	if err != nil {
@@ -74,6 +126,24 @@ func (co *containerResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	if previousId != "" {
+		defer func() {
+			if !resp.Diagnostics.HasError() {
+				return
+			}
+
+			err := blueGreenRollback(ctx, c, out.Id)
+
+			if err != nil {
+				resp.Diagnostics.AddError("Container rollback failed", err.Error())
+
+				return
+			}
+
+			resp.State.RemoveResource(ctx)
+		}()
+	}
+
 	tflog.Trace(ctx, "Container created", map[string]any{"id": out.Id})
 
 	for _, warning := range out.Warnings {
@@ -81,7 +151,12 @@ func (co *containerResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	data.Id = types.StringValue(out.Id)
+	resp.Diagnostics.Append(readHostPorts(ctx, c, out.Id, &data.PortMappings, &data.AssignedHostPorts)...)
+	data.ExitCode = types.Int64Null()
+	data.Stderr = types.StringNull()
+	data.Stdout = types.StringNull()
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Id, data.ContainerHost)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -126,13 +201,85 @@ func (co *containerResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 	}
 
-	if data.StartImmediately.ValueBool() {
+	if !data.Job.IsNull() {
+		resp.Diagnostics.Append(runJob(ctx, c, &data)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+
+		return
+	}
+
+	if !data.DesiredState.IsNull() {
+		err = reconcileState(ctx, c, out.Id, data.DesiredState.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("desired_state"), "Container state change failed", err.Error())
+
+			return
+		}
+	} else if data.StartImmediately.ValueBool() {
 		err = c.ContainerStart(ctx, out.Id)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Container start failed", err.Error())
+
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(waitForStartup(ctx, c, out.Id, &data.WaitFor)...)
+
+	if previousId == "" || resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = blueGreenSwap(ctx, c, previousId, out.Id, data.Name.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("blue_green"), "Container replacement failed", err.Error())
+	}
+}
+
+func writeAutoUpdate(ctx context.Context, in *types.Object, out *map[string]string) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if in.IsNull() {
+		return result
+	}
+
+	var model containerResourceAutoUpdateModel
+	result.Append(in.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	if result.HasError() {
+		return result
+	}
+
+	if *out == nil {
+		*out = make(map[string]string)
+	}
+
+	labels := map[string]types.String{
+		autoUpdateLabel:         model.Policy,
+		autoUpdateAuthfileLabel: model.Authfile,
+	}
+
+	for key, value := range labels {
+		if value.IsNull() {
+			continue
+		}
+
+		if _, ok := (*out)[key]; ok {
+			result.AddAttributeError(
+				path.Root("labels"),
+				"Conflicting labels",
+				fmt.Sprintf("The %s label is set by the auto_update attribute and must not be set in labels", key))
+
+			continue
 		}
+
+		(*out)[key] = value.ValueString()
 	}
+
+	return result
 }
 
 func writeDevices(ctx context.Context, in *types.List, out *[]api.ContainerCreateDeviceJson) diag.Diagnostics {
@@ -190,6 +337,23 @@ func writeDuration(in *types.Number, out *time.Duration) diag.Diagnostics {
 	return result
 }
 
+func writeExtraHosts(ctx context.Context, in *types.List, out *[]string) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	models := make([]containerResourceExtraHostModel, 0)
+	result.Append(in.ElementsAs(ctx, &models, false)...)
+
+	if result.HasError() {
+		return result
+	}
+
+	for _, model := range models {
+		*out = append(*out, model.Hostname.ValueString()+":"+model.Ip.ValueString())
+	}
+
+	return result
+}
+
 func writeHealth(ctx context.Context, in *types.Object, out **api.ContainerCreateHealthConfigJson) diag.Diagnostics {
 	var result diag.Diagnostics
 	var model containerResourceHealthModel
@@ -254,32 +418,35 @@ func writeHealthCheck(ctx context.Context, in *types.Object, out *[]string) diag
 	return result
 }
 
-func writeMounts(ctx context.Context, in *types.List, out *[]api.ContainerCreateMountJson) diag.Diagnostics {
+func writeLogging(ctx context.Context, driver *types.String, in *types.Object, out **api.ContainerCreateLogConfigJson) diag.Diagnostics {
 	var result diag.Diagnostics
 
-	models := make([]containerResourceMountModel, 0)
-	result.Append(in.ElementsAs(ctx, &models, false)...)
-
-	if result.HasError() {
+	if driver.IsNull() && in.IsNull() {
 		return result
 	}
 
-	for _, inItem := range models {
-		var options []string
-		result.Append(inItem.Options.ElementsAs(ctx, &options, false)...)
+	json := &api.ContainerCreateLogConfigJson{
+		Driver: driver.ValueString(),
+	}
+
+	if !in.IsNull() {
+		var model containerResourceLogOptionsModel
+		result.Append(in.As(ctx, &model, basetypes.ObjectAsOptions{})...)
 
 		if result.HasError() {
 			return result
 		}
 
-		*out = append(*out, api.ContainerCreateMountJson{
-			Destination: inItem.Target.ValueString(),
-			Options:     options,
-			Source:      inItem.Source.ValueString(),
-			Type:        inItem.Type.ValueString(),
-		})
+		json.Path = model.Path.ValueString()
+		json.Size = model.MaxSize.ValueInt64()
+
+		if !model.Tag.IsNull() {
+			json.Options = map[string]string{"tag": model.Tag.ValueString()}
+		}
 	}
 
+	*out = json
+
 	return result
 }
 
@@ -322,7 +489,9 @@ func writeNetworks(ctx context.Context, in *types.List, out *map[string]api.Cont
 	}
 
 	for _, model := range models {
-		(*out)[model.Id.ValueString()] = api.ContainerCreateNetworkJson{}
+		json, d := networkOptions(ctx, &model)
+		result.Append(d...)
+		(*out)[model.Id.ValueString()] = json
 	}
 
 	return result
@@ -357,12 +526,87 @@ func writePortMappings(ctx context.Context, in *types.List, out *[]api.Container
 			HostIP:        hostIpStr,
 			HostPort:      uint16(model.HostPort.ValueInt32()),
 			Protocol:      strings.Join(protocolList, ","),
+			Range:         uint16(model.Range.ValueInt32()),
 		})
 	}
 
 	return result
 }
 
+// The CPU quota is expressed as a fraction of this period, in microseconds.
+const cpuPeriod = 100000
+
+func writeResources(ctx context.Context, in *types.Object, out **api.ContainerCreateResourceLimitsJson, shmSize **int64) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if in.IsNull() {
+		return result
+	}
+
+	var model containerResourceLimitsModel
+	result.Append(in.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	if result.HasError() {
+		return result
+	}
+
+	json := &api.ContainerCreateResourceLimitsJson{}
+
+	if !model.BlkioWeight.IsNull() {
+		weight := uint16(model.BlkioWeight.ValueInt64())
+		json.BlockIO = &api.ContainerCreateBlockIOJson{Weight: &weight}
+	}
+
+	if !model.CpuShares.IsNull() || !model.Cpus.IsNull() || !model.CpusetCpus.IsNull() || !model.CpusetMems.IsNull() {
+		json.CPU = &api.ContainerCreateCPUJson{
+			Cpus: model.CpusetCpus.ValueString(),
+			Mems: model.CpusetMems.ValueString(),
+		}
+
+		if !model.CpuShares.IsNull() {
+			shares := uint64(model.CpuShares.ValueInt64())
+			json.CPU.Shares = &shares
+		}
+
+		if !model.Cpus.IsNull() {
+			period := uint64(cpuPeriod)
+			quota := int64(model.Cpus.ValueFloat64() * cpuPeriod)
+			json.CPU.Period = &period
+			json.CPU.Quota = &quota
+		}
+	}
+
+	if !model.Memory.IsNull() || !model.MemoryReservation.IsNull() || !model.MemorySwap.IsNull() {
+		json.Memory = &api.ContainerCreateMemoryJson{
+			Limit:       model.Memory.ValueInt64Pointer(),
+			Reservation: model.MemoryReservation.ValueInt64Pointer(),
+			Swap:        model.MemorySwap.ValueInt64Pointer(),
+		}
+	}
+
+	if !model.PidsLimit.IsNull() {
+		json.Pids = &api.ContainerCreatePidsJson{Limit: model.PidsLimit.ValueInt64()}
+	}
+
+	*out = json
+	*shmSize = model.ShmSize.ValueInt64Pointer()
+
+	return result
+}
+
+func writeRestartRetries(in *types.Int32, out **uint) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if in.IsNull() {
+		return result
+	}
+
+	retries := uint(in.ValueInt32())
+	*out = &retries
+
+	return result
+}
+
 func writeSecrets(ctx context.Context, in *types.List, out *[]api.ContainerCreateSecretJson) diag.Diagnostics {
 	var result diag.Diagnostics
 
@@ -386,6 +630,91 @@ func writeSecrets(ctx context.Context, in *types.List, out *[]api.ContainerCreat
 	return result
 }
 
+func writeSecurity(ctx context.Context, in *types.Object, out *api.ContainerCreateJson) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if in.IsNull() {
+		return result
+	}
+
+	var model containerResourceSecurityModel
+	result.Append(in.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	if result.HasError() {
+		return result
+	}
+
+	result.Append(model.CapAdd.ElementsAs(ctx, &out.CapAdd, false)...)
+	result.Append(model.CapDrop.ElementsAs(ctx, &out.CapDrop, false)...)
+	result.Append(model.MaskedPaths.ElementsAs(ctx, &out.Mask, false)...)
+	result.Append(model.UnmaskedPaths.ElementsAs(ctx, &out.Unmask, false)...)
+
+	out.ApparmorProfile = model.ApparmorProfile.ValueString()
+	out.NoNewPrivileges = model.NoNewPrivileges.ValueBoolPointer()
+	out.Privileged = model.Privileged.ValueBoolPointer()
+	out.ReadOnlyFilesystem = model.ReadOnlyRootfs.ValueBoolPointer()
+	out.SeccompProfilePath = model.SeccompProfilePath.ValueString()
+
+	// Podman's flag is named after the root filesystem being read-only, but what it controls is
+	// whether writable tmpfs mounts are added in that case.
+	out.ReadWriteTmpfs = model.ReadOnlyTmpfs.ValueBoolPointer()
+
+	return result
+}
+
+func writeStop(signal *types.String, timeout *types.Int32, out *api.ContainerCreateJson) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if !signal.IsNull() {
+		number, ok := linuxSignals[signal.ValueString()]
+
+		if !ok {
+			result.AddAttributeError(path.Root("stop_signal"), "Unknown signal", signal.ValueString())
+
+			return result
+		}
+
+		out.StopSignal = &number
+	}
+
+	if !timeout.IsNull() {
+		seconds := uint(timeout.ValueInt32())
+		out.StopTimeout = &seconds
+	}
+
+	return result
+}
+
+func writeUlimits(ctx context.Context, in *types.List, out *[]api.ContainerCreateRlimitJson) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	models := make([]containerResourceUlimitModel, 0)
+	result.Append(in.ElementsAs(ctx, &models, false)...)
+
+	if result.HasError() {
+		return result
+	}
+
+	// -1 means unlimited, which the OCI runtime spec represents as the largest possible value
+	limit := func(value types.Int64) uint64 {
+		if value.ValueInt64() < 0 {
+			return math.MaxUint64
+		}
+
+		return uint64(value.ValueInt64())
+	}
+
+	for _, model := range models {
+		*out = append(*out, api.ContainerCreateRlimitJson{
+			Hard: limit(model.Hard),
+			Soft: limit(model.Soft),
+			Type: "RLIMIT_" + strings.ToUpper(model.Name.ValueString()),
+		})
+	}
+
+	return result
+}
+
 func writeUser(ctx context.Context, in *types.Object, out *string) diag.Diagnostics {
 	var result diag.Diagnostics
 
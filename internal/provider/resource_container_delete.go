@@ -23,7 +23,14 @@ func (co *containerResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err = c.ContainerStop(ctx, id)
+	var timeout *int
+
+	if !data.StopTimeout.IsNull() {
+		seconds := int(data.StopTimeout.ValueInt32())
+		timeout = &seconds
+	}
+
+	err = c.ContainerStop(ctx, id, timeout)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error stopping container", err.Error())
@@ -31,7 +38,7 @@ func (co *containerResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err = c.ContainerDelete(ctx, id)
+	err = c.ContainerDelete(ctx, id, data.RemoveVolumes.ValueBool())
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting container", err.Error())
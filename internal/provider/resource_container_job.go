@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Starts a job container, waits for it to exit and records its exit code and (optionally) its
+// output in `data`. The container may have run before, in which case it is started again.
+func runJob(ctx context.Context, c *client.Client, data *containerResourceModel) diag.Diagnostics {
+	var result diag.Diagnostics
+	var model containerResourceJobModel
+	result.Append(data.Job.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	data.ExitCode = types.Int64Null()
+	data.Stderr = types.StringNull()
+	data.Stdout = types.StringNull()
+
+	if result.HasError() {
+		return result
+	}
+
+	id := data.Id.ValueString()
+	err := c.ContainerStart(ctx, id)
+
+	if err != nil {
+		result.AddError("Container start failed", err.Error())
+
+		return result
+	}
+
+	tflog.Trace(ctx, "Waiting for job", map[string]any{"id": id})
+	waitCtx := ctx
+
+	if !model.Timeout.IsNull() {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, time.Duration(model.Timeout.ValueInt32())*time.Second)
+		defer cancel()
+	}
+
+	exitCode, err := c.ContainerWait(waitCtx, id)
+
+	if err != nil {
+		detail := err.Error() + containerFailureDetails(ctx, c, id)
+
+		// Don't leave a job that has timed out (or whose outcome is otherwise unknown) running in
+		// the background. Podman kills the container if it ignores its stop signal.
+		if ctx.Err() == nil {
+			var timeout *int
+
+			if !data.StopTimeout.IsNull() {
+				seconds := int(data.StopTimeout.ValueInt32())
+				timeout = &seconds
+			}
+
+			err = c.ContainerStop(ctx, id, timeout)
+
+			if err != nil {
+				detail += "\n\nThe container could not be stopped: " + err.Error()
+			}
+		}
+
+		result.AddAttributeError(path.Root("job"), "Error waiting for job to finish", detail)
+
+		return result
+	}
+
+	data.ExitCode = types.Int64Value(int64(exitCode))
+
+	if model.CaptureOutput.ValueBool() {
+		stdout, stderr, err := c.ContainerOutput(ctx, id)
+
+		if err != nil {
+			result.AddAttributeError(path.Root("job"), "Error reading job output", err.Error())
+
+			return result
+		}
+
+		data.Stderr = types.StringValue(stderr)
+		data.Stdout = types.StringValue(stdout)
+	}
+
+	if exitCode != 0 && !model.AllowFailure.ValueBool() {
+		result.AddAttributeError(
+			path.Root("job"),
+			"Job failed",
+			fmt.Sprintf("Container %s exited with code %d", id, exitCode)+containerFailureDetails(ctx, c, id))
+	}
+
+	return result
+}
+
+// Reports whether a job needs to run again because its triggers have changed.
+func jobTriggersChanged(before types.Object, after types.Object) bool {
+	if after.IsNull() {
+		return false
+	}
+
+	if after.IsUnknown() {
+		return true
+	}
+
+	var triggers attr.Value = types.MapNull(types.StringType)
+
+	if !before.IsNull() && !before.IsUnknown() {
+		triggers = before.Attributes()["triggers"]
+	}
+
+	return !after.Attributes()["triggers"].Equal(triggers)
+}
+
+// The job's outputs are preserved from the previous run unless it is going to run again.
+func planJobOutputs(ctx context.Context, state *containerResourceModel, data *containerResourceModel, resp *resource.ModifyPlanResponse) {
+	if state == nil || !jobTriggersChanged(state.Job, data.Job) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("exit_code"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("stderr"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("stdout"), types.StringUnknown())...)
+}
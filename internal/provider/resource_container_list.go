@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+)
+
+func newContainerListResource() list.ListResource {
+	return &containerResource{}
+}
+
+func (co *containerResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listConfigSchema("container", "Only list containers whose name matches this regular expression.")
+}
+
+// Only the attributes that the list endpoint reports are populated. The rest of the container's
+// configuration can not be recovered from Podman, so the generated configuration will need to be
+// completed by hand before it can be applied without replacing the container.
+func (co *containerResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	c, data, filters, diags := co.beginList(ctx, req, "name")
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	containers, err := c.ContainerList(ctx, filters)
+
+	if err != nil {
+		diags.AddError("Error listing containers", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	items := make([]listItem, 0, len(containers))
+
+	for _, container := range containers {
+		item := listItem{
+			attributes: map[string]any{
+				"image": container.ImageID,
+			},
+			id:   container.Id,
+			name: container.Id,
+		}
+
+		if len(container.Names) > 0 {
+			item.attributes["name"] = container.Names[0]
+			item.name = container.Names[0]
+		}
+
+		if len(container.Labels) > 0 {
+			item.attributes["labels"] = container.Labels
+		}
+
+		items = append(items, item)
+	}
+
+	stream.Results = listResults(ctx, req, data, items)
+}
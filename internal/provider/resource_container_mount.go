@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The typed options that each kind of mount accepts. Anything else can still be passed through
+// `options`, which Podman validates when the container is created. Other mount types are passed
+// through to Podman unchecked, and accept no typed options.
+var mountTypeOptions = map[string][]string{
+	"bind":    {"chown", "idmap", "propagation", "read_only"},
+	"devpts":  {"mode"},
+	"image":   {"read_only", "subpath"},
+	"overlay": {},
+	"tmpfs":   {"chown", "mode", "read_only", "size"},
+	"volume":  {"chown", "idmap", "read_only", "subpath"},
+}
+
+var mountPropagations = []string{"private", "rprivate", "rshared", "rslave", "shared", "slave"}
+
+func mountTypedOptions(model *containerResourceMountModel) map[string]attr.Value {
+	return map[string]attr.Value{
+		"chown":       model.Chown,
+		"idmap":       model.Idmap,
+		"mode":        model.Mode,
+		"propagation": model.Propagation,
+		"read_only":   model.ReadOnly,
+		"size":        model.Size,
+		"subpath":     model.Subpath,
+	}
+}
+
+// Checks that each mount only sets the typed options that apply to its type, and that `source` is
+// set for the types that need one. tmpfs and devpts mounts have no source, but an empty string is
+// still accepted since earlier versions of this provider required one.
+func validateMounts(ctx context.Context, in *types.List) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if in.IsNull() || in.IsUnknown() {
+		return result
+	}
+
+	models := make([]containerResourceMountModel, 0)
+	result.Append(in.ElementsAs(ctx, &models, false)...)
+
+	if result.HasError() {
+		return result
+	}
+
+	for i, model := range models {
+		if model.Type.IsUnknown() {
+			continue
+		}
+
+		mountType := model.Type.ValueString()
+
+		if model.Type.IsNull() {
+			mountType = "bind"
+		}
+
+		allowed := mountTypeOptions[mountType]
+		options := mountTypedOptions(&model)
+
+		for _, name := range slices.Sorted(maps.Keys(options)) {
+			if !options[name].IsNull() && !slices.Contains(allowed, name) {
+				result.AddAttributeError(
+					path.Root("mounts").AtListIndex(i).AtName(name),
+					"Invalid attribute combination",
+					fmt.Sprintf("%s can not be set on a %s mount", name, mountType))
+			}
+		}
+
+		if mountType == "image" && !model.Options.IsNull() {
+			result.AddAttributeError(
+				path.Root("mounts").AtListIndex(i).AtName("options"),
+				"Invalid attribute combination",
+				"options can not be set on an image mount")
+		}
+
+		if model.Source.IsUnknown() {
+			continue
+		}
+
+		switch mountType {
+		case "bind", "image", "overlay":
+			if model.Source.ValueString() == "" {
+				result.AddAttributeError(
+					path.Root("mounts").AtListIndex(i).AtName("source"),
+					"Missing source",
+					fmt.Sprintf("source must be set on a %s mount", mountType))
+			}
+		case "devpts", "tmpfs":
+			if model.Source.ValueString() != "" {
+				result.AddAttributeError(
+					path.Root("mounts").AtListIndex(i).AtName("source"),
+					"Invalid attribute combination",
+					fmt.Sprintf("source can not be set on a %s mount", mountType))
+			}
+		}
+	}
+
+	return result
+}
+
+// Named volumes, image mounts and overlays each have their own field in libpod's create spec, and
+// the remaining types are passed through as OCI runtime mounts.
+func writeMounts(ctx context.Context, in *types.List, out *api.ContainerCreateJson) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	models := make([]containerResourceMountModel, 0)
+	result.Append(in.ElementsAs(ctx, &models, false)...)
+
+	if result.HasError() {
+		return result
+	}
+
+	for _, model := range models {
+		var options []string
+
+		if model.Chown.ValueBool() {
+			options = append(options, "U")
+		}
+
+		if model.Idmap.ValueBool() {
+			options = append(options, "idmap")
+		}
+
+		if !model.Mode.IsNull() {
+			options = append(options, fmt.Sprintf("mode=%o", model.Mode.ValueInt32()))
+		}
+
+		if !model.Propagation.IsNull() {
+			options = append(options, model.Propagation.ValueString())
+		}
+
+		if model.ReadOnly.ValueBool() && model.Type.ValueString() != "image" {
+			options = append(options, "ro")
+		}
+
+		if !model.Size.IsNull() {
+			options = append(options, fmt.Sprintf("size=%d", model.Size.ValueInt64()))
+		}
+
+		extra := make([]string, 0)
+		result.Append(model.Options.ElementsAs(ctx, &extra, false)...)
+
+		if result.HasError() {
+			return result
+		}
+
+		options = append(options, extra...)
+
+		switch model.Type.ValueString() {
+		case "image":
+			out.ImageVolumes = append(out.ImageVolumes, api.ContainerCreateImageVolumeJson{
+				Destination: model.Target.ValueString(),
+				ReadWrite:   !model.ReadOnly.IsNull() && !model.ReadOnly.ValueBool(),
+				Source:      model.Source.ValueString(),
+				SubPath:     model.Subpath.ValueString(),
+			})
+		case "overlay":
+			out.OverlayVolumes = append(out.OverlayVolumes, api.ContainerCreateOverlayVolumeJson{
+				Destination: model.Target.ValueString(),
+				Options:     options,
+				Source:      model.Source.ValueString(),
+			})
+		case "volume":
+			out.Volumes = append(out.Volumes, api.ContainerCreateNamedVolumeJson{
+				Dest:        model.Target.ValueString(),
+				IsAnonymous: model.Source.ValueString() == "",
+				Name:        model.Source.ValueString(),
+				Options:     options,
+				SubPath:     model.Subpath.ValueString(),
+			})
+		default:
+			out.Mounts = append(out.Mounts, api.ContainerCreateMountJson{
+				Destination: model.Target.ValueString(),
+				Options:     options,
+				Source:      model.Source.ValueString(),
+				Type:        model.Type.ValueString(),
+			})
+		}
+	}
+
+	return result
+}
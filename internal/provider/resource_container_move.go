@@ -0,0 +1,736 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dockerContainerState struct {
+	Capabilities     []dockerCapabilitiesState     `json:"capabilities"`
+	Command          []string                      `json:"command"`
+	CpuSet           string                        `json:"cpu_set"`
+	CpuShares        int64                         `json:"cpu_shares"`
+	Cpus             string                        `json:"cpus"`
+	Devices          []dockerContainerDeviceState  `json:"devices"`
+	Dns              []string                      `json:"dns"`
+	DnsOpts          []string                      `json:"dns_opts"`
+	DnsSearch        []string                      `json:"dns_search"`
+	Entrypoint       []string                      `json:"entrypoint"`
+	Env              []string                      `json:"env"`
+	Healthcheck      []dockerContainerHealthState  `json:"healthcheck"`
+	Host             []dockerContainerHostState    `json:"host"`
+	Hostname         string                        `json:"hostname"`
+	Id               string                        `json:"id"`
+	Image            string                        `json:"image"`
+	Init             bool                          `json:"init"`
+	Labels           []dockerLabelState            `json:"labels"`
+	LogDriver        string                        `json:"log_driver"`
+	LogOpts          map[string]string             `json:"log_opts"`
+	MaxRetryCount    int32                         `json:"max_retry_count"`
+	Memory           int64                         `json:"memory"`
+	MemorySwap       int64                         `json:"memory_swap"`
+	Mounts           []dockerContainerMountState   `json:"mounts"`
+	Name             string                        `json:"name"`
+	NetworkMode      string                        `json:"network_mode"`
+	NetworksAdvanced []dockerContainerNetworkState `json:"networks_advanced"`
+	Ports            []dockerContainerPortState    `json:"ports"`
+	Privileged       bool                          `json:"privileged"`
+	ReadOnly         bool                          `json:"read_only"`
+	Restart          string                        `json:"restart"`
+	Start            *bool                         `json:"start"`
+	Sysctls          map[string]string             `json:"sysctls"`
+	Ulimit           []dockerContainerUlimitState  `json:"ulimit"`
+	User             string                        `json:"user"`
+	Volumes          []dockerContainerVolumeState  `json:"volumes"`
+	WorkingDir       string                        `json:"working_dir"`
+}
+
+type dockerCapabilitiesState struct {
+	Add  []string `json:"add"`
+	Drop []string `json:"drop"`
+}
+
+type dockerContainerDeviceState struct {
+	ContainerPath string `json:"container_path"`
+	HostPath      string `json:"host_path"`
+	Permissions   string `json:"permissions"`
+}
+
+type dockerContainerHealthState struct {
+	Interval    string   `json:"interval"`
+	Retries     int32    `json:"retries"`
+	StartPeriod string   `json:"start_period"`
+	Test        []string `json:"test"`
+	Timeout     string   `json:"timeout"`
+}
+
+type dockerContainerHostState struct {
+	Host string `json:"host"`
+	Ip   string `json:"ip"`
+}
+
+type dockerLabelState struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+type dockerContainerMountState struct {
+	BindOptions []struct {
+		Propagation string `json:"propagation"`
+	} `json:"bind_options"`
+	ReadOnly     bool   `json:"read_only"`
+	Source       string `json:"source"`
+	Target       string `json:"target"`
+	TmpfsOptions []struct {
+		Mode      int64 `json:"mode"`
+		SizeBytes int64 `json:"size_bytes"`
+	} `json:"tmpfs_options"`
+	Type          string `json:"type"`
+	VolumeOptions []struct {
+		DriverName    string             `json:"driver_name"`
+		DriverOptions map[string]string  `json:"driver_options"`
+		Labels        []dockerLabelState `json:"labels"`
+		NoCopy        bool               `json:"no_copy"`
+	} `json:"volume_options"`
+}
+
+type dockerContainerNetworkState struct {
+	Aliases     []string `json:"aliases"`
+	Ipv4Address string   `json:"ipv4_address"`
+	Ipv6Address string   `json:"ipv6_address"`
+	Name        string   `json:"name"`
+}
+
+type dockerContainerPortState struct {
+	External int32  `json:"external"`
+	Internal int32  `json:"internal"`
+	Ip       string `json:"ip"`
+	Protocol string `json:"protocol"`
+}
+
+type dockerContainerUlimitState struct {
+	Hard int64  `json:"hard"`
+	Name string `json:"name"`
+	Soft int64  `json:"soft"`
+}
+
+type dockerContainerVolumeState struct {
+	ContainerPath string `json:"container_path"`
+	FromContainer string `json:"from_container"`
+	HostPath      string `json:"host_path"`
+	ReadOnly      bool   `json:"read_only"`
+	VolumeName    string `json:"volume_name"`
+}
+
+func (co *containerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: moveDockerContainer,
+		},
+	}
+}
+
+// Convert the state of a `docker_container` resource. Attributes that the Docker provider
+// computes from the image when they are not configured (e.g. `command`) are copied verbatim, so
+// they need to be set explicitly in the new configuration to avoid a replacement. The same goes
+// for `image`: the Docker state only records what was configured, usually an image ID, and there
+// is no image reference to fall back on, so a configuration that names the image by reference is
+// replaced once after the move.
+func moveDockerContainer(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	var in dockerContainerState
+	raw, diags := decodeDockerState(req, "docker_container", &in)
+	resp.Diagnostics.Append(diags...)
+
+	if raw == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	untranslated := dockerUntranslated(raw,
+		"attach", "cgroupns_mode", "domainname", "gpus", "group_add", "logs", "pid_mode",
+		"publish_all_ports", "rm", "stdin_open", "storage_opts", "tmpfs", "tty", "upload",
+		"userns_mode", "wait")
+
+	state := &resp.TargetState
+	set := func(p path.Path, value any) {
+		resp.Diagnostics.Append(state.SetAttribute(ctx, p, value)...)
+	}
+
+	set(path.Root("id"), in.Id)
+	set(path.Root("image"), trimDockerImageId(in.Image))
+	set(path.Root("name"), in.Name)
+	set(path.Root("start_immediately"), in.Start == nil || *in.Start)
+
+	if len(in.Command) > 0 {
+		set(path.Root("command"), in.Command)
+	}
+
+	if len(in.Entrypoint) > 0 {
+		set(path.Root("entrypoint"), in.Entrypoint)
+	}
+
+	if in.Init {
+		set(path.Root("init"), true)
+	}
+
+	if in.WorkingDir != "" {
+		set(path.Root("working_dir"), in.WorkingDir)
+	}
+
+	if in.Restart != "" && in.Restart != "no" {
+		set(path.Root("restart_policy"), in.Restart)
+	}
+
+	if in.Restart == "on-failure" && in.MaxRetryCount > 0 {
+		set(path.Root("restart_retries"), in.MaxRetryCount)
+	}
+
+	if len(in.Env) > 0 {
+		env := make(map[string]string)
+
+		for _, item := range in.Env {
+			key, value, _ := strings.Cut(item, "=")
+			env[key] = value
+		}
+
+		set(path.Root("env"), env)
+	}
+
+	if len(in.Labels) > 0 {
+		set(path.Root("labels"), dockerLabels(in.Labels))
+	}
+
+	if in.User != "" {
+		user, group, hasGroup := strings.Cut(in.User, ":")
+		model := containerResourceUserModel{
+			Group: types.StringNull(),
+			User:  types.StringValue(user),
+		}
+
+		if hasGroup {
+			model.Group = types.StringValue(group)
+		}
+
+		set(path.Root("user"), model)
+	}
+
+	// The Docker provider records the hostname that Docker generates from the container's ID if
+	// none was configured, which is Podman's default too
+	if in.Hostname != "" && !strings.HasPrefix(in.Id, in.Hostname) {
+		set(path.Root("hostname"), in.Hostname)
+	}
+
+	if len(in.Dns) > 0 {
+		set(path.Root("dns_servers"), in.Dns)
+	}
+
+	if len(in.DnsOpts) > 0 {
+		set(path.Root("dns_options"), in.DnsOpts)
+	}
+
+	if len(in.DnsSearch) > 0 {
+		set(path.Root("dns_search"), in.DnsSearch)
+	}
+
+	if len(in.Host) > 0 {
+		hosts := make([]containerResourceExtraHostModel, 0)
+
+		for _, host := range in.Host {
+			hosts = append(hosts, containerResourceExtraHostModel{
+				Hostname: types.StringValue(host.Host),
+				Ip:       iptypes.NewIPAddressValue(host.Ip),
+			})
+		}
+
+		set(path.Root("extra_hosts"), hosts)
+	}
+
+	set(path.Root("network_namespace"), dockerNetworkNamespace(in.NetworkMode))
+
+	if len(in.NetworksAdvanced) > 0 {
+		networks := make([]containerResourceNetworkModel, 0)
+
+		for _, network := range in.NetworksAdvanced {
+			model := containerResourceNetworkModel{
+				Aliases:       types.ListNull(types.StringType),
+				Id:            types.StringValue(network.Name),
+				InterfaceName: types.StringNull(),
+				Ipv4Address:   iptypes.NewIPv4AddressNull(),
+				Ipv6Address:   iptypes.NewIPv6AddressNull(),
+				MacAddress:    types.StringNull(),
+			}
+
+			if len(network.Aliases) > 0 {
+				aliases, diags := types.ListValueFrom(ctx, types.StringType, network.Aliases)
+				resp.Diagnostics.Append(diags...)
+				model.Aliases = aliases
+			}
+
+			if network.Ipv4Address != "" {
+				model.Ipv4Address = iptypes.NewIPv4AddressValue(network.Ipv4Address)
+			}
+
+			if network.Ipv6Address != "" {
+				model.Ipv6Address = iptypes.NewIPv6AddressValue(network.Ipv6Address)
+			}
+
+			networks = append(networks, model)
+		}
+
+		set(path.Root("networks"), networks)
+	}
+
+	if len(in.Ports) > 0 {
+		portMappings := make([]containerResourcePortMappingModel, 0)
+
+		for _, port := range in.Ports {
+			model := containerResourcePortMappingModel{
+				ContainerPort: types.Int32Value(port.Internal),
+				HostIP:        iptypes.NewIPAddressNull(),
+				HostPort:      types.Int32Value(port.External),
+				Protocols:     types.ListNull(types.StringType),
+				Range:         types.Int32Null(),
+			}
+
+			if port.Ip != "" && port.Ip != "0.0.0.0" {
+				model.HostIP = iptypes.NewIPAddressValue(port.Ip)
+			}
+
+			if port.Protocol != "" && port.Protocol != "tcp" {
+				model.Protocols = types.ListValueMust(
+					types.StringType,
+					[]attr.Value{types.StringValue(port.Protocol)})
+			}
+
+			portMappings = append(portMappings, model)
+		}
+
+		set(path.Root("port_mappings"), portMappings)
+	}
+
+	if len(in.Devices) > 0 {
+		devices := make([]containerResourceDeviceModel, 0)
+
+		for _, device := range in.Devices {
+			if (device.ContainerPath != "" && device.ContainerPath != device.HostPath) ||
+				(device.Permissions != "" && device.Permissions != "rwm") {
+				untranslated = append(untranslated, "devices")
+			}
+
+			devices = append(devices, containerResourceDeviceModel{
+				Path: types.StringValue(device.HostPath),
+			})
+		}
+
+		set(path.Root("devices"), devices)
+	}
+
+	if len(in.Sysctls) > 0 {
+		set(path.Root("sysctls"), in.Sysctls)
+	}
+
+	if len(in.Ulimit) > 0 {
+		ulimits := make([]containerResourceUlimitModel, 0)
+
+		for _, ulimit := range in.Ulimit {
+			ulimits = append(ulimits, containerResourceUlimitModel{
+				Hard: types.Int64Value(ulimit.Hard),
+				Name: types.StringValue(ulimit.Name),
+				Soft: types.Int64Value(ulimit.Soft),
+			})
+		}
+
+		set(path.Root("ulimits"), ulimits)
+	}
+
+	logDriver, logOptions, loggingUntranslated := dockerLogging(&in)
+	untranslated = append(untranslated, loggingUntranslated...)
+
+	if logDriver != "" {
+		set(path.Root("log_driver"), logDriver)
+	}
+
+	if logOptions != nil {
+		set(path.Root("log_options"), logOptions)
+	}
+
+	resources, resourcesUntranslated := dockerResources(&in)
+	untranslated = append(untranslated, resourcesUntranslated...)
+
+	if resources != nil {
+		set(path.Root("resources"), resources)
+	}
+
+	security, diags := dockerSecurity(ctx, &in)
+	resp.Diagnostics.Append(diags...)
+
+	if security != nil {
+		set(path.Root("security"), security)
+	}
+
+	mounts, volumesFrom, mountsUntranslated := dockerMounts(&in)
+	untranslated = append(untranslated, mountsUntranslated...)
+
+	if len(mounts) > 0 {
+		set(path.Root("mounts"), mounts)
+	}
+
+	if len(volumesFrom) > 0 {
+		set(path.Root("volumes_from"), volumesFrom)
+	}
+
+	if len(in.Healthcheck) > 0 {
+		resp.Diagnostics.Append(dockerHealth(ctx, state, &in.Healthcheck[0])...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(warnUntranslated("docker_container", untranslated)...)
+}
+
+func dockerLabels(in []dockerLabelState) map[string]string {
+	result := make(map[string]string)
+
+	for _, label := range in {
+		result[label.Label] = label.Value
+	}
+
+	return result
+}
+
+// Docker's "default" network mode is a bridge network, as is ours.
+func dockerNetworkNamespace(mode string) containerResourceNamespaceModel {
+	result := containerResourceNamespaceModel{
+		Mode:    types.StringValue("bridge"),
+		Options: types.ListNull(types.StringType),
+	}
+
+	if target, ok := strings.CutPrefix(mode, "container:"); ok {
+		result.Mode = types.StringValue("container")
+		result.Options = types.ListValueMust(types.StringType, []attr.Value{types.StringValue(target)})
+	} else if mode != "" && mode != "default" {
+		result.Mode = types.StringValue(mode)
+	}
+
+	return result
+}
+
+// Docker's `json-file` driver corresponds to Podman's `k8s-file` driver, which Podman also accepts
+// under Docker's name. Only the options that have an equivalent in `log_options` are translated.
+func dockerLogging(in *dockerContainerState) (string, *containerResourceLogOptionsModel, []string) {
+	untranslated := make([]string, 0)
+	driver := ""
+
+	switch in.LogDriver {
+	case "":
+	case "journald", "k8s-file", "none", "passthrough":
+		driver = in.LogDriver
+	case "json-file":
+		driver = "k8s-file"
+	default:
+		untranslated = append(untranslated, "log_driver")
+	}
+
+	if len(in.LogOpts) == 0 {
+		return driver, nil, untranslated
+	}
+
+	model := &containerResourceLogOptionsModel{
+		MaxSize: types.Int64Null(),
+		Path:    types.StringNull(),
+		Tag:     types.StringNull(),
+	}
+
+	for key, value := range in.LogOpts {
+		size, ok := parseDockerSize(value)
+
+		switch {
+		case key == "tag" && (driver == "journald" || driver == "k8s-file"):
+			model.Tag = types.StringValue(value)
+		case key == "max-size" && driver == "k8s-file" && ok:
+			model.MaxSize = types.Int64Value(size)
+		default:
+			untranslated = append(untranslated, "log_opts")
+		}
+	}
+
+	if model.MaxSize.IsNull() && model.Tag.IsNull() {
+		model = nil
+	}
+
+	return driver, model, untranslated
+}
+
+// Parses a size such as `10m` in the format of Docker's log options, where units are powers of
+// 1024.
+func parseDockerSize(value string) (int64, bool) {
+	units := map[byte]int64{'k': 1 << 10, 'm': 1 << 20, 'g': 1 << 30}
+	value = strings.TrimSuffix(strings.ToLower(value), "b")
+	multiplier := int64(1)
+
+	if len(value) > 0 && units[value[len(value)-1]] != 0 {
+		multiplier = units[value[len(value)-1]]
+		value = value[:len(value)-1]
+	}
+
+	number, err := strconv.ParseInt(value, 10, 64)
+
+	if err != nil {
+		return 0, false
+	}
+
+	return number * multiplier, true
+}
+
+// Docker's memory limits are given in megabytes and ours are given in bytes. The Docker provider
+// may report a swap limit of -1 even if no memory limit is set, in which case it has no effect.
+func dockerResources(in *dockerContainerState) (*containerResourceLimitsModel, []string) {
+	untranslated := make([]string, 0)
+
+	if in.CpuSet == "" && in.CpuShares == 0 && in.Cpus == "" && in.Memory <= 0 {
+		return nil, untranslated
+	}
+
+	model := &containerResourceLimitsModel{
+		BlkioWeight:       types.Int64Null(),
+		CpuShares:         types.Int64Null(),
+		Cpus:              types.Float64Null(),
+		CpusetCpus:        types.StringNull(),
+		CpusetMems:        types.StringNull(),
+		Memory:            types.Int64Null(),
+		MemoryReservation: types.Int64Null(),
+		MemorySwap:        types.Int64Null(),
+		PidsLimit:         types.Int64Null(),
+		ShmSize:           types.Int64Null(),
+	}
+
+	if in.CpuSet != "" {
+		model.CpusetCpus = types.StringValue(in.CpuSet)
+	}
+
+	if in.CpuShares != 0 {
+		model.CpuShares = types.Int64Value(in.CpuShares)
+	}
+
+	if in.Cpus != "" {
+		cpus, err := strconv.ParseFloat(in.Cpus, 64)
+
+		if err == nil {
+			model.Cpus = types.Float64Value(cpus)
+		} else {
+			untranslated = append(untranslated, "cpus")
+		}
+	}
+
+	if in.Memory > 0 {
+		model.Memory = types.Int64Value(in.Memory * 1024 * 1024)
+
+		if in.MemorySwap > 0 {
+			model.MemorySwap = types.Int64Value(in.MemorySwap * 1024 * 1024)
+		} else if in.MemorySwap < 0 {
+			model.MemorySwap = types.Int64Value(-1)
+		}
+	}
+
+	return model, untranslated
+}
+
+func dockerSecurity(ctx context.Context, in *dockerContainerState) (*containerResourceSecurityModel, diag.Diagnostics) {
+	var result diag.Diagnostics
+
+	if len(in.Capabilities) == 0 && !in.Privileged && !in.ReadOnly {
+		return nil, result
+	}
+
+	model := &containerResourceSecurityModel{
+		ApparmorProfile:    types.StringNull(),
+		CapAdd:             types.ListNull(types.StringType),
+		CapDrop:            types.ListNull(types.StringType),
+		MaskedPaths:        types.ListNull(types.StringType),
+		NoNewPrivileges:    types.BoolNull(),
+		Privileged:         types.BoolNull(),
+		ReadOnlyRootfs:     types.BoolNull(),
+		ReadOnlyTmpfs:      types.BoolNull(),
+		SeccompProfilePath: types.StringNull(),
+		UnmaskedPaths:      types.ListNull(types.StringType),
+	}
+
+	for _, capabilities := range in.Capabilities {
+		if len(capabilities.Add) > 0 {
+			list, d := types.ListValueFrom(ctx, types.StringType, capabilities.Add)
+			result.Append(d...)
+			model.CapAdd = list
+		}
+
+		if len(capabilities.Drop) > 0 {
+			list, d := types.ListValueFrom(ctx, types.StringType, capabilities.Drop)
+			result.Append(d...)
+			model.CapDrop = list
+		}
+	}
+
+	if in.Privileged {
+		model.Privileged = types.BoolValue(true)
+	}
+
+	if in.ReadOnly {
+		model.ReadOnlyRootfs = types.BoolValue(true)
+	}
+
+	return model, result
+}
+
+// Docker's volumes are either mounts or references to another container's volumes, which become
+// `volumes_from` entries.
+func dockerMounts(in *dockerContainerState) ([]containerResourceMountModel, []string, []string) {
+	result := make([]containerResourceMountModel, 0)
+	volumesFrom := make([]string, 0)
+	untranslated := make([]string, 0)
+
+	makeModel := func(mountType, source, target string, readOnly bool) containerResourceMountModel {
+		model := containerResourceMountModel{
+			Chown:       types.BoolNull(),
+			Idmap:       types.BoolNull(),
+			Mode:        types.Int32Null(),
+			Options:     types.ListNull(types.StringType),
+			Propagation: types.StringNull(),
+			ReadOnly:    types.BoolNull(),
+			Size:        types.Int64Null(),
+			Source:      types.StringNull(),
+			Subpath:     types.StringNull(),
+			Target:      types.StringValue(target),
+			Type:        types.StringValue(mountType),
+		}
+
+		if source != "" {
+			model.Source = types.StringValue(source)
+		}
+
+		if readOnly {
+			model.ReadOnly = types.BoolValue(true)
+		}
+
+		return model
+	}
+
+	for _, mount := range in.Mounts {
+		mountType := mount.Type
+
+		if mountType == "" {
+			mountType = "bind"
+		}
+
+		model := makeModel(mountType, mount.Source, mount.Target, mount.ReadOnly)
+
+		for _, bind := range mount.BindOptions {
+			if bind.Propagation != "" {
+				model.Propagation = types.StringValue(bind.Propagation)
+			}
+		}
+
+		for _, tmpfs := range mount.TmpfsOptions {
+			if tmpfs.SizeBytes != 0 {
+				model.Size = types.Int64Value(tmpfs.SizeBytes)
+			}
+
+			if tmpfs.Mode != 0 {
+				model.Mode = types.Int32Value(int32(tmpfs.Mode))
+			}
+		}
+
+		for _, volume := range mount.VolumeOptions {
+			if volume.NoCopy {
+				model.Options = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("nocopy")})
+			}
+
+			if volume.DriverName != "" || len(volume.DriverOptions) > 0 || len(volume.Labels) > 0 {
+				untranslated = append(untranslated, "mounts.volume_options")
+			}
+		}
+
+		result = append(result, model)
+	}
+
+	for _, volume := range in.Volumes {
+		switch {
+		case volume.FromContainer != "" && volume.ReadOnly:
+			volumesFrom = append(volumesFrom, volume.FromContainer+":ro")
+		case volume.FromContainer != "":
+			volumesFrom = append(volumesFrom, volume.FromContainer)
+		case volume.VolumeName != "":
+			result = append(result, makeModel("volume", volume.VolumeName, volume.ContainerPath, volume.ReadOnly))
+		case volume.HostPath != "":
+			result = append(result, makeModel("bind", volume.HostPath, volume.ContainerPath, volume.ReadOnly))
+		}
+	}
+
+	return result, volumesFrom, untranslated
+}
+
+func dockerHealth(ctx context.Context, state *tfsdk.State, in *dockerContainerHealthState) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	set := func(p path.Path, value any) {
+		result.Append(state.SetAttribute(ctx, p, value)...)
+	}
+
+	health := path.Root("health")
+	check := health.AtName("check")
+
+	if len(in.Test) > 0 {
+		switch in.Test[0] {
+		case "NONE":
+			set(check.AtName("disabled"), true)
+		case "CMD":
+			set(check.AtName("command"), in.Test[1:])
+		case "CMD-SHELL":
+			set(check.AtName("shell_command"), strings.Join(in.Test[1:], " "))
+		}
+	}
+
+	durations := map[string]string{
+		"interval":     in.Interval,
+		"start_period": in.StartPeriod,
+		"timeout":      in.Timeout,
+	}
+
+	for name, value := range durations {
+		if value == "" {
+			continue
+		}
+
+		d, err := time.ParseDuration(value)
+
+		if err != nil {
+			result.AddAttributeError(
+				health.AtName(name),
+				"Invalid Docker health check duration",
+				fmt.Sprintf("Unable to parse duration \"%s\": %s", value, err.Error()))
+
+			continue
+		}
+
+		if d != 0 {
+			set(health.AtName(name), types.NumberValue(big.NewFloat(d.Seconds())))
+		}
+	}
+
+	if in.Retries != 0 {
+		set(health.AtName("retries"), in.Retries)
+	}
+
+	return result
+}
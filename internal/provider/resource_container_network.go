@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Translates the options of a `networks` entry (or of a `podman_network_attachment`) into the
+// per-network options that libpod accepts both at create time and when connecting a container.
+func networkOptions(ctx context.Context, model *containerResourceNetworkModel) (api.ContainerCreateNetworkJson, diag.Diagnostics) {
+	var json api.ContainerCreateNetworkJson
+	result := model.Aliases.ElementsAs(ctx, &json.Aliases, false)
+
+	for _, address := range []basetypes.StringValue{model.Ipv4Address.StringValue, model.Ipv6Address.StringValue} {
+		if !address.IsNull() {
+			json.StaticIPs = append(json.StaticIPs, address.ValueString())
+		}
+	}
+
+	json.InterfaceName = model.InterfaceName.ValueString()
+	json.StaticMAC = model.MacAddress.ValueString()
+
+	return json, result
+}
+
+// Disconnects a container from the networks that have been removed from its `networks` list and
+// connects it to the networks that have been added. A network whose options have changed is
+// disconnected and then connected again. Switching between no networks and some networks
+// replaces the container instead, see requiresReplaceIfDefaultNetworkChanged().
+func updateNetworks(ctx context.Context, c *client.Client, id string, before types.List, after types.List) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	beforeAry := make([]containerResourceNetworkModel, 0)
+	result.Append(before.ElementsAs(ctx, &beforeAry, false)...)
+
+	afterAry := make([]containerResourceNetworkModel, 0)
+	result.Append(after.ElementsAs(ctx, &afterAry, false)...)
+
+	if result.HasError() {
+		return result
+	}
+
+	beforeIdx := make(map[string]containerResourceNetworkModel)
+
+	for _, model := range beforeAry {
+		beforeIdx[model.Id.ValueString()] = model
+	}
+
+	afterIdx := make(map[string]containerResourceNetworkModel)
+	afterPos := make(map[string]int)
+
+	for i, model := range afterAry {
+		afterIdx[model.Id.ValueString()] = model
+		afterPos[model.Id.ValueString()] = i
+	}
+
+	for _, model := range beforeAry {
+		network := model.Id.ValueString()
+		next, ok := afterIdx[network]
+
+		if ok && networkModelsEqual(&model, &next) {
+			continue
+		}
+
+		err := c.NetworkDisconnect(ctx, network, &api.NetworkDisconnectJson{Container: id})
+
+		// Diagnostics refer to the planned list, which no longer contains a removed network
+		if err != nil {
+			p := path.Root("networks")
+
+			if pos, ok := afterPos[network]; ok {
+				p = p.AtListIndex(pos)
+			}
+
+			result.AddAttributeError(p, "Error disconnecting network", fmt.Sprintf("Network %s: %s", network, err.Error()))
+
+			return result
+		}
+
+		tflog.Trace(ctx, "Disconnected network", map[string]any{"id": id, "network": network})
+	}
+
+	for i, model := range afterAry {
+		network := model.Id.ValueString()
+		prev, ok := beforeIdx[network]
+
+		if ok && networkModelsEqual(&prev, &model) {
+			continue
+		}
+
+		json, d := networkOptions(ctx, &model)
+		result.Append(d...)
+
+		if result.HasError() {
+			return result
+		}
+
+		err := c.NetworkConnect(ctx, network, &api.NetworkConnectJson{
+			Container:                  id,
+			ContainerCreateNetworkJson: json,
+		})
+
+		if err != nil {
+			result.AddAttributeError(path.Root("networks").AtListIndex(i), "Error connecting network", err.Error())
+
+			return result
+		}
+
+		tflog.Trace(ctx, "Connected network", map[string]any{"id": id, "network": network})
+	}
+
+	return result
+}
+
+func networkModelsEqual(a *containerResourceNetworkModel, b *containerResourceNetworkModel) bool {
+	return a.Aliases.Equal(b.Aliases) &&
+		a.Id.Equal(b.Id) &&
+		a.InterfaceName.Equal(b.InterfaceName) &&
+		a.Ipv4Address.Equal(b.Ipv4Address) &&
+		a.Ipv6Address.Equal(b.Ipv6Address) &&
+		a.MacAddress.Equal(b.MacAddress)
+}
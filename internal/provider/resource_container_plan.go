@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Check the planned container against the current state of the container host, so that
+// references to missing objects and port clashes are reported during `terraform plan` instead of
+// partway through `terraform apply`. Values that are not yet known (typically IDs of resources
+// that will be created by this same plan) are not checked.
+//
+// This also marks the outputs of a job container as unknown if the job is going to run again.
+func (co *containerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data containerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ownId string
+	var state *containerResourceModel
+
+	if !req.State.Raw.IsNull() {
+		state = &containerResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		ownId = state.Id.ValueString()
+	}
+
+	planJobOutputs(ctx, state, &data, resp)
+
+	if co.ps == nil || data.ContainerHost.IsUnknown() {
+		return
+	}
+
+	co.planRestartPolicy(ctx, state, &data, resp)
+
+	if co.ps.SkipPlanChecks {
+		return
+	}
+
+	c, err := co.ps.getClient(ctx, data.ContainerHost.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Plan-time checks skipped",
+			"Unable to connect to the container host, so the image, networks, secrets and host ports "+
+				"used by this container have not been checked: "+err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(checkImage(ctx, c, &data)...)
+	resp.Diagnostics.Append(checkNetworks(ctx, c, &data)...)
+	resp.Diagnostics.Append(checkSecrets(ctx, c, &data)...)
+	resp.Diagnostics.Append(checkPortMappings(ctx, c, &data, ownId)...)
+}
+
+// Podman versions before 5.0 can't change the restart policy of an existing container, so the
+// container has to be replaced instead. This decides between an update and a replacement, so
+// unlike the checks above it also contacts the container host when plan-time checks are skipped,
+// but only if the restart policy has changed. If the container host can't be contacted or doesn't
+// report its version then an in-place update is planned, which fails during apply if the host
+// turns out to be too old.
+func (co *containerResource) planRestartPolicy(ctx context.Context, state *containerResourceModel, data *containerResourceModel, resp *resource.ModifyPlanResponse) {
+	if state == nil || (data.RestartPolicy.Equal(state.RestartPolicy) && data.RestartRetries.Equal(state.RestartRetries)) {
+		return
+	}
+
+	c, err := co.ps.getClient(ctx, data.ContainerHost.ValueString())
+
+	if err != nil || !c.ApiVersionKnown() || c.ApiVersionAtLeast(5, 0) {
+		return
+	}
+
+	if !data.RestartPolicy.Equal(state.RestartPolicy) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("restart_policy"))
+	}
+
+	if !data.RestartRetries.Equal(state.RestartRetries) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("restart_retries"))
+	}
+}
+
+// Like secrets, an image or network may be referred to by a literal name although it is only
+// created by this plan, so a missing image or network only produces a warning.
+func checkImage(ctx context.Context, c *client.Client, data *containerResourceModel) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if data.Image.IsUnknown() {
+		return result
+	}
+
+	image := data.Image.ValueString()
+	_, err := c.ImageInspect(ctx, image)
+
+	if isNotFound(err) {
+		result.AddAttributeWarning(
+			path.Root("image"),
+			"Image not found",
+			fmt.Sprintf("Image \"%s\" does not exist on the container host. This is expected if "+
+				"the image is pulled elsewhere in this plan, otherwise container creation will fail.",
+				image))
+	} else if err != nil {
+		result.AddAttributeWarning(path.Root("image"), "Unable to check image", err.Error())
+	}
+
+	return result
+}
+
+func checkNetworks(ctx context.Context, c *client.Client, data *containerResourceModel) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if data.Networks.IsUnknown() {
+		return result
+	}
+
+	models := make([]containerResourceNetworkModel, 0)
+	result.Append(data.Networks.ElementsAs(ctx, &models, false)...)
+
+	if result.HasError() {
+		return result
+	}
+
+	for i, model := range models {
+		if model.Id.IsUnknown() {
+			continue
+		}
+
+		attrPath := path.Root("networks").AtListIndex(i).AtName("id")
+		network := model.Id.ValueString()
+		_, err := c.NetworkInspect(ctx, network)
+
+		if isNotFound(err) {
+			result.AddAttributeWarning(
+				attrPath,
+				"Network not found",
+				fmt.Sprintf("Network \"%s\" does not exist on the container host. This is expected if "+
+					"the network is created elsewhere in this plan, otherwise container creation will fail.",
+					network))
+		} else if err != nil {
+			result.AddAttributeWarning(attrPath, "Unable to check network", err.Error())
+		}
+	}
+
+	return result
+}
+
+// Secret names are usually known at plan time even if the secret itself will only be created by
+// this plan, so a missing secret only produces a warning.
+func checkSecrets(ctx context.Context, c *client.Client, data *containerResourceModel) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	check := func(attrPath path.Path, secret types.String) {
+		if secret.IsUnknown() || secret.IsNull() {
+			return
+		}
+
+		_, err := c.SecretInspect(ctx, secret.ValueString())
+
+		if isNotFound(err) {
+			result.AddAttributeWarning(
+				attrPath,
+				"Secret not found",
+				fmt.Sprintf("Secret \"%s\" does not exist on the container host. This is expected if "+
+					"the secret is created elsewhere in this plan, otherwise container creation will fail.",
+					secret.ValueString()))
+		} else if err != nil {
+			result.AddAttributeWarning(attrPath, "Unable to check secret", err.Error())
+		}
+	}
+
+	if !data.Secrets.IsUnknown() {
+		models := make([]containerResourceSecretModel, 0)
+		result.Append(data.Secrets.ElementsAs(ctx, &models, false)...)
+
+		if result.HasError() {
+			return result
+		}
+
+		for i, model := range models {
+			check(path.Root("secrets").AtListIndex(i).AtName("secret"), model.Secret)
+		}
+	}
+
+	if !data.SecretEnv.IsUnknown() {
+		secretEnv := make(map[string]types.String)
+		result.Append(data.SecretEnv.ElementsAs(ctx, &secretEnv, false)...)
+
+		if result.HasError() {
+			return result
+		}
+
+		for key, secret := range secretEnv {
+			check(path.Root("secret_env").AtMapKey(key), secret)
+		}
+	}
+
+	return result
+}
+
+// The container that this container replaces may already hold its host ports. Terraform plans a
+// replacement without a prior state, so in that case the replaced container can only be recognised
+// by its name. Without a name there is no way to tell, so clashes with running containers are only
+// reported as warnings.
+func checkPortMappings(ctx context.Context, c *client.Client, data *containerResourceModel, ownId string) diag.Diagnostics {
+	var result diag.Diagnostics
+	var ownName string
+
+	if ownId == "" && !data.Name.IsUnknown() {
+		ownName = data.Name.ValueString()
+	}
+
+	identified := ownId != "" || ownName != ""
+
+	if data.PortMappings.IsUnknown() || data.PortMappings.IsNull() {
+		return result
+	}
+
+	models := make([]containerResourcePortMappingModel, 0)
+	result.Append(data.PortMappings.ElementsAs(ctx, &models, false)...)
+
+	if result.HasError() {
+		return result
+	}
+
+	containers, err := c.ContainerList(ctx, nil)
+
+	if err != nil {
+		result.AddAttributeWarning(path.Root("port_mappings"), "Unable to check host ports", err.Error())
+
+		return result
+	}
+
+	for i, model := range models {
+		if model.HostPort.IsUnknown() || model.HostIP.IsUnknown() || model.Protocols.IsUnknown() {
+			continue
+		}
+
+		protocols := make([]string, 0)
+		result.Append(model.Protocols.ElementsAs(ctx, &protocols, false)...)
+
+		if result.HasError() {
+			return result
+		}
+
+		want := api.ContainerCreatePortMappingJson{
+			HostIP:   model.HostIP.ValueString(),
+			HostPort: uint16(model.HostPort.ValueInt32()),
+			Protocol: strings.Join(protocols, ","),
+			Range:    uint16(model.Range.ValueInt32()),
+		}
+
+		for _, other := range containers {
+			otherName := displayName(other.Names, other.Id)
+
+			if other.Id == ownId || (ownName != "" && otherName == ownName) {
+				continue
+			}
+
+			for _, bound := range other.Ports {
+				if !portMappingsClash(&want, &bound) {
+					continue
+				}
+
+				attrPath := path.Root("port_mappings").AtListIndex(i).AtName("host_port")
+				summary := "Host port already in use"
+				detail := fmt.Sprintf("Host port %d is already bound by container %s.", want.HostPort, otherName)
+
+				switch {
+				case other.State != "running":
+					result.AddAttributeWarning(
+						attrPath,
+						summary,
+						detail+" That container is not currently running, but this container will "+
+							"fail to start if both containers are running at the same time.")
+				case !identified:
+					result.AddAttributeWarning(
+						attrPath,
+						summary,
+						detail+" This container has no name, so if it replaces that container then "+
+							"this can be ignored, otherwise this container will fail to start.")
+				default:
+					result.AddAttributeError(attrPath, summary, detail)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func portMappingsClash(a, b *api.ContainerCreatePortMappingJson) bool {
+	if a.HostPort == 0 || !portRangesOverlap(a, b) {
+		return false
+	}
+
+	if !isWildcardIP(a.HostIP) && !isWildcardIP(b.HostIP) && a.HostIP != b.HostIP {
+		return false
+	}
+
+	return protocolsOverlap(a, b)
+}
+
+// Compares the blocks of host ports that two mappings bind. A range of zero means a single port.
+func portRangesOverlap(a, b *api.ContainerCreatePortMappingJson) bool {
+	aEnd := int(a.HostPort) + int(max(a.Range, 1))
+	bEnd := int(b.HostPort) + int(max(b.Range, 1))
+
+	return int(a.HostPort) < bEnd && int(b.HostPort) < aEnd
+}
+
+func protocolsOverlap(a, b *api.ContainerCreatePortMappingJson) bool {
+	aProtocols := portProtocols(a.Protocol)
+	bProtocols := portProtocols(b.Protocol)
+
+	for _, protocol := range aProtocols {
+		if slices.Contains(bProtocols, protocol) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isWildcardIP(ip string) bool {
+	return ip == "" || ip == "0.0.0.0" || ip == "::"
+}
+
+func portProtocols(protocol string) []string {
+	if protocol == "" {
+		return []string{"tcp"}
+	}
+
+	return strings.Split(protocol, ",")
+}
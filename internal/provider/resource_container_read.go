@@ -2,10 +2,18 @@ package provider
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
+	"github.com/decafcode/terraform-provider-podman/internal/api"
 	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 func (co *containerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -39,5 +47,201 @@ func (co *containerResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	data.Name = types.StringValue(json.Name)
+	// A container's name is optional, and Podman generates one if it is not set. Only track it if
+	// it was configured or if this container is being imported (in which case `image` is null).
+	if !data.Name.IsNull() || data.Image.IsNull() {
+		data.Name = types.StringValue(json.Name)
+	}
+
+	if data.Image.IsNull() {
+		data.Image = types.StringValue(json.Image)
+	}
+
+	if !data.DesiredState.IsNull() {
+		data.DesiredState = types.StringValue(observedState(data.DesiredState.ValueString(), json.State.Status))
+	}
+
+	data.Id = types.StringValue(json.Id)
+	resp.Diagnostics.Append(readHostSettings(ctx, json, &data)...)
+	resp.Diagnostics.Append(readLogging(ctx, json, &data)...)
+	readProcessSettings(json, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Id, data.ContainerHost)...)
+}
+
+// Finds the host port that Podman bound for each port mapping, which is only known once the
+// container has been created if the mapping's `host_port` was omitted.
+func readHostPorts(ctx context.Context, c *client.Client, id string, in *types.List, out *types.List) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if in.IsNull() {
+		*out = types.ListNull(types.Int32Type)
+
+		return result
+	}
+
+	models := make([]containerResourcePortMappingModel, 0)
+	result.Append(in.ElementsAs(ctx, &models, false)...)
+
+	if result.HasError() {
+		return result
+	}
+
+	containers, err := c.ContainerList(ctx, api.ListFilters{"id": {id}})
+
+	if err != nil {
+		result.AddAttributeError(path.Root("assigned_host_ports"), "Error listing container", err.Error())
+
+		return result
+	}
+
+	var bound []api.ContainerCreatePortMappingJson
+
+	if len(containers) == 1 {
+		bound = containers[0].Ports
+	}
+
+	ports := make([]attr.Value, 0, len(models))
+
+	for _, model := range models {
+		protocols := make([]string, 0)
+		result.Append(model.Protocols.ElementsAs(ctx, &protocols, false)...)
+
+		want := api.ContainerCreatePortMappingJson{
+			ContainerPort: uint16(model.ContainerPort.ValueInt32()),
+			HostIP:        model.HostIP.ValueString(),
+			Protocol:      strings.Join(protocols, ","),
+		}
+
+		port := types.Int32Null()
+
+		for _, mapping := range bound {
+			if mapping.ContainerPort == want.ContainerPort && mapping.HostIP == want.HostIP && protocolsOverlap(&want, &mapping) {
+				port = types.Int32Value(int32(mapping.HostPort))
+
+				break
+			}
+		}
+
+		ports = append(ports, port)
+	}
+
+	list, d := types.ListValue(types.Int32Type, ports)
+	result.Append(d...)
+	*out = list
+
+	return result
+}
+
+// Podman reports these settings as they were configured, so any difference means that the
+// container was changed outside of Terraform. Settings that are not configured are left alone,
+// since Podman reports its defaults for them.
+func readHostSettings(ctx context.Context, json *api.ContainerInspectJson, data *containerResourceModel) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if !data.Hostname.IsNull() {
+		data.Hostname = types.StringValue(json.Config.Hostname)
+	}
+
+	result.Append(readList(ctx, json.HostConfig.Dns, &data.DnsServers)...)
+	result.Append(readList(ctx, json.HostConfig.DnsOptions, &data.DnsOptions)...)
+	result.Append(readList(ctx, json.HostConfig.DnsSearch, &data.DnsSearch)...)
+
+	hosts := make([]containerResourceExtraHostModel, 0)
+
+	for _, entry := range json.HostConfig.ExtraHosts {
+		// Hostnames can not contain colons, but IPv6 addresses can
+		hostname, ip, _ := strings.Cut(entry, ":")
+		hosts = append(hosts, containerResourceExtraHostModel{
+			Hostname: types.StringValue(hostname),
+			Ip:       iptypes.NewIPAddressValue(ip),
+		})
+	}
+
+	result.Append(readList(ctx, hosts, &data.ExtraHosts)...)
+
+	return result
+}
+
+// Like the host settings, only the configured logging settings are compared. The maximum size is
+// not compared because Podman reports it rounded to a human-readable unit.
+func readLogging(ctx context.Context, json *api.ContainerInspectJson, data *containerResourceModel) diag.Diagnostics {
+	var result diag.Diagnostics
+	log := &json.HostConfig.LogConfig
+
+	if !data.LogDriver.IsNull() {
+		data.LogDriver = types.StringValue(log.Type)
+	}
+
+	if data.LogOptions.IsNull() {
+		return result
+	}
+
+	var model containerResourceLogOptionsModel
+	result.Append(data.LogOptions.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	if result.HasError() {
+		return result
+	}
+
+	if !model.Path.IsNull() {
+		model.Path = types.StringValue(log.Path)
+	}
+
+	if !model.Tag.IsNull() {
+		model.Tag = types.StringValue(log.Tag)
+	}
+
+	options, d := types.ObjectValueFrom(ctx, data.LogOptions.AttributeTypes(ctx), &model)
+	result.Append(d...)
+	data.LogOptions = options
+
+	return result
+}
+
+// Like the host settings, only the configured process settings are compared. env_host and
+// unsetenv are not compared because Podman only reports the environment that results from them.
+func readProcessSettings(json *api.ContainerInspectJson, data *containerResourceModel) {
+	if !data.Init.IsNull() {
+		data.Init = types.BoolValue(json.HostConfig.Init)
+	}
+
+	if !data.Timezone.IsNull() {
+		data.Timezone = types.StringValue(json.Config.Timezone)
+	}
+
+	// Podman reports the umask with a leading zero, however it was configured
+	if !data.Umask.IsNull() {
+		configured, errConfigured := strconv.ParseUint(data.Umask.ValueString(), 8, 32)
+		observed, errObserved := strconv.ParseUint(json.Config.Umask, 8, 32)
+
+		if errConfigured != nil || errObserved != nil || configured != observed {
+			data.Umask = types.StringValue(json.Config.Umask)
+		}
+	}
+
+	if !data.WorkingDir.IsNull() {
+		data.WorkingDir = types.StringValue(json.Config.WorkingDir)
+	}
+}
+
+// Replaces a configured list with the values that Podman reports. A list that is not configured is
+// left null, and a configured list is never set to null so that an empty list stays empty.
+func readList[T any](ctx context.Context, values []T, out *types.List) diag.Diagnostics {
+	if out.IsNull() {
+		return nil
+	}
+
+	if values == nil {
+		values = make([]T, 0)
+	}
+
+	list, diags := types.ListValueFrom(ctx, out.ElementType(ctx), values)
+
+	if !diags.HasError() {
+		*out = list
+	}
+
+	return diags
 }
@@ -2,18 +2,29 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
@@ -23,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -39,6 +51,42 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Podman Container resource",
 		Attributes: map[string]schema.Attribute{
+			"assigned_host_ports": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Int32Type,
+				MarkdownDescription: "The host port that is bound for each entry in `port_mappings`, in the same order. This is the port that Podman picked for entries that don't set a `host_port`, and the first port of the block for entries that set a `range`.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_update": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"authfile": schema.StringAttribute{
+						MarkdownDescription: "Path on the container host of the registry credentials file to use when checking for updates, in the format used by `podman login`.",
+						Optional:            true,
+					},
+					"policy": schema.StringAttribute{
+						MarkdownDescription: "`registry` to pull newer images from the registry that this container's image reference points to, or `local` to use newer images that have been pulled or built on the container host by some other means.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("registry", "local"),
+						},
+					},
+				},
+				MarkdownDescription: "Opt this container in to [podman auto-update](https://docs.podman.io/en/v5.5.2/markdown/podman-auto-update.1.html) by setting the `io.containers.autoupdate` labels.\n\n" +
+					"  Note that `podman auto-update` only updates containers that are run by a systemd unit, which is not the case for containers that are launched by this provider unless the unit is set up separately, and that it is not available through the Podman API and has to be run on the container host itself (e.g. by its `podman-auto-update.timer` systemd unit). Rollback is controlled by the `--rollback` option of `podman auto-update`, not by the container.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"blue_green": schema.BoolAttribute{
+				MarkdownDescription: "Replace this container without downtime. Requires `name` and `wait_for` to be set, and `create_before_destroy` to be enabled in the resource's `lifecycle` block.\n\n" +
+					"  When this container is replaced, the new container is created with a `-next` suffix on its name, started, and checked using `wait_for`. If it becomes ready then the old container is stopped and renamed with a `-previous` suffix, the new container takes over its name, and the old container is then destroyed as usual. Otherwise the new container is deleted and the old container is left running. The old and new containers run side by side for a while, so they can't bind the same host ports, and clients should reach the container through a network alias rather than its name.\n\n" +
+					"  Containers created with this setting are labelled so that they can be recognised when they are replaced. If the name is already held by a container without this label then creation fails instead of replacing that container.\n\n" +
+					"  Turning this on for an existing container does not replace it, so that container has no label and the next replacement fails in this way. Rename the existing container out of the way before applying that replacement, e.g. with `podman rename web web-previous`. The new container then takes the name straight away, and the renamed container is destroyed as usual.",
+				Optional: true,
+			},
 			"command": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
@@ -54,6 +102,15 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"desired_state": schema.StringAttribute{
+				MarkdownDescription: "The state that this container should be kept in: `running`, `paused`, `stopped` or `created`. `stopped` and `created` are equivalent, and mean that the container exists but is not running.\n\n" +
+					"  If this is set then every refresh compares it against the container's actual state, and `terraform apply` starts, stops, pauses or unpauses the container to bring it back to this state without replacing it. For example, a `running` container that has crashed or been stopped by hand is started again. This replaces `start_immediately`, which can't be set at the same time.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("running", "paused", "stopped", "created"),
+					stringvalidator.ConflictsWith(path.MatchRoot("start_immediately")),
+				},
+			},
 			"devices": schema.ListNestedAttribute{
 				MarkdownDescription: "A list of device nodes to make available to the container.",
 				Optional:            true,
@@ -69,6 +126,30 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 					listplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"dns_options": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Options to add to the container's `/etc/resolv.conf`, e.g. `ndots:2`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"dns_search": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Search domains to add to the container's `/etc/resolv.conf`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"dns_servers": schema.ListAttribute{
+				ElementType:         iptypes.IPAddressType{},
+				MarkdownDescription: "IPv4 or IPv6 addresses of the DNS servers that the container uses, instead of those of its networks or the host.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
 			"entrypoint": schema.ListAttribute{
 				ElementType:         types.StringType,
 				MarkdownDescription: "Override the container entry point supplied by the image.",
@@ -85,6 +166,43 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 					mapplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"env_host": schema.BoolAttribute{
+				MarkdownDescription: "Copy the environment variables of the Podman service into the container, underneath `env`. For a remote `container_host` this is the environment of the Podman API service on that host, not Terraform's.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"exit_code": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Exit code of the most recent run of a `job` container. Null for other containers.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"extra_hosts": schema.ListNestedAttribute{
+				MarkdownDescription: "Additional entries to add to the container's `/etc/hosts` file.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Hostname to resolve.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(hostnameRegexp, "must be a valid hostname"),
+							},
+						},
+						"ip": schema.StringAttribute{
+							CustomType:          iptypes.IPAddressType{},
+							MarkdownDescription: "IPv4 or IPv6 address that the hostname resolves to.",
+							Required:            true,
+						},
+					},
+				},
+				Optional: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
 			"health": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"check": schema.SingleNestedAttribute{
@@ -152,6 +270,16 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 					objectplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the container, as seen by its own processes. Defaults to the start of the container's ID.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(hostnameRegexp, "must be a valid hostname"),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Container ID assigned by Podman",
@@ -166,6 +294,67 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 				Required: true,
 			},
+			"init": schema.BoolAttribute{
+				MarkdownDescription: "Run a minimal init process as the container's PID 1, which forwards signals to the container's command and reaps zombie processes.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"init_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the init binary on the container host to use for `init`, instead of Podman's default `catatonit`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("init")),
+					stringvalidator.RegexMatches(absolutePathRegexp, "must be an absolute path"),
+				},
+			},
+			"job": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"allow_failure": schema.BoolAttribute{
+						MarkdownDescription: "Do not fail the apply if the job exits with a non-zero exit code. Defaults to false.",
+						Optional:            true,
+					},
+					"capture_output": schema.BoolAttribute{
+						MarkdownDescription: "Record the job's standard output and standard error in the `stdout` and `stderr` attributes. These are stored in the Terraform state, so this should only be used for jobs with a small amount of output that is not secret. Defaults to false.",
+						Optional:            true,
+					},
+					"timeout": schema.Int32Attribute{
+						MarkdownDescription: "Maximum number of seconds to wait for the job to finish. If the job takes longer then its container is stopped, using `stop_signal` and `stop_timeout`, and the apply fails. Unlimited by default.",
+						Optional:            true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"triggers": schema.MapAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Arbitrary values that run the job again in the existing container whenever they change, for example the version of a database schema.",
+						Optional:            true,
+					},
+				},
+				MarkdownDescription: "Run this container to completion as a one-shot job, such as a database migration. The container is started when it is created, and the apply waits for it to exit. A non-zero exit code fails the apply, and marks the container as tainted so that the job is run again in a new container next time.\n\n" +
+					"  Changes to `job.triggers` run the job again without replacing the container. Changes to the other `job` attributes take effect the next time the job runs.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+						},
+						"Adding or removing job requires the container to be replaced.",
+						"Adding or removing `job` requires the container to be replaced.",
+					),
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(
+						path.MatchRoot("desired_state"),
+						path.MatchRoot("start_immediately"),
+						path.MatchRoot("wait_for"),
+					),
+				},
+			},
 			"labels": schema.MapAttribute{
 				ElementType:         types.StringType,
 				MarkdownDescription: "Labels to attach to this container in the Podman and Docker API.",
@@ -174,31 +363,107 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 					mapplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"log_driver": schema.StringAttribute{
+				MarkdownDescription: "Where Podman sends the container's output: `journald`, a `k8s-file` log file, `passthrough` to the process that started the container, or `none`. Defaults to the host's `containers.conf`, which is usually `journald`. Note that `podman logs` and `wait_for.log_pattern` do not work with `none` or `passthrough`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("journald", "k8s-file", "none", "passthrough"),
+				},
+			},
+			"log_options": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"max_size": schema.Int64Attribute{
+						MarkdownDescription: "Maximum size of the `k8s-file` log file in bytes. Podman truncates the file when it reaches this size. Unlimited by default.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(8192),
+						},
+					},
+					"path": schema.StringAttribute{
+						MarkdownDescription: "Path of the `k8s-file` log file on the host. Defaults to a file in the container's storage directory.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(absolutePathRegexp, "must be an absolute path"),
+						},
+					},
+					"tag": schema.StringAttribute{
+						MarkdownDescription: "Tag that identifies the container's log entries, e.g. the `SYSLOG_IDENTIFIER` of `journald` entries. May contain Go template expressions such as `{{.Name}}`.",
+						Optional:            true,
+					},
+				},
+				MarkdownDescription: "Options for `log_driver`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
 			"mounts": schema.ListNestedAttribute{
-				MarkdownDescription: "A list of host filesystem locations or block devices to mount into the container's mount namespace.\n\n" +
-					"  The default type is a bind mount (i.e. make a host directory appear inside the container), but other possibilities also exist depending on the value of the `type` attribute.\n\n" +
+				MarkdownDescription: "A list of filesystems to mount into the container's mount namespace.\n\n" +
+					"  The default type is a bind mount (i.e. make a host directory appear inside the container), but other possibilities also exist depending on the value of the `type` attribute. Each type accepts a different subset of the typed options below.\n\n" +
 					"  See [Podman docs](https://docs.podman.io/en/v5.5.2/markdown/podman-create.1.html#mount-type-type-type-specific-option) for more details, but be sure to also consult the note about the `options` attribute below.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"chown": schema.BoolAttribute{
+							MarkdownDescription: "Change the owner of the mounted files to the user that the container runs as, like the `U` option. Applies to `bind`, `tmpfs` and `volume` mounts.",
+							Optional:            true,
+						},
+						"idmap": schema.BoolAttribute{
+							MarkdownDescription: "Create an ID-mapped mount, so that files are owned by the same users inside the container as outside of its user namespace. Applies to `bind` and `volume` mounts.",
+							Optional:            true,
+						},
+						"mode": schema.Int32Attribute{
+							MarkdownDescription: "Numerical file mode of the mount's root directory, e.g. `parseint(\"1777\", 8)`. Applies to `tmpfs` and `devpts` mounts.",
+							Optional:            true,
+							Validators: []validator.Int32{
+								int32validator.Between(0, 07777),
+							},
+						},
 						"options": schema.ListAttribute{
 							ElementType: types.StringType,
-							MarkdownDescription: "Mount options as described in the link to Podman's docs above. Note that the documentation for this specific attribute is [here](https://docs.podman.io/en/v5.5.2/markdown/podman-create.1.html#volume-v-source-volume-host-dir-container-dir-options) and not in the section describing mounts. This seems to be a quirk of the Podman API.\n\n" +
+							MarkdownDescription: "Additional mount options as described in the link to Podman's docs above, which are added after the typed options. Note that the documentation for this specific attribute is [here](https://docs.podman.io/en/v5.5.2/markdown/podman-create.1.html#volume-v-source-volume-host-dir-container-dir-options) and not in the section describing mounts. This seems to be a quirk of the Podman API. Not supported by `image` mounts.\n\n" +
 								"  Note: If you are specifying a bind mount (the default mount type) and the host machine has SELinux enabled (which is usually the case, since Podman is typically used from Red Hat based distributions) then you will want to specify `[\"Z\"]` here, otherwise the processes running in the container will be denied access to this mount.",
 							Optional: true,
 						},
+						"propagation": schema.StringAttribute{
+							MarkdownDescription: "Mount propagation mode of a `bind` mount: `private`, `rprivate`, `shared`, `rshared`, `slave` or `rslave`.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(mountPropagations...),
+							},
+						},
+						"read_only": schema.BoolAttribute{
+							MarkdownDescription: "Mount the filesystem read-only. Applies to `bind`, `image`, `tmpfs` and `volume` mounts. `image` mounts are read-only unless this is set to `false`, in which case changes are discarded when the container is removed.",
+							Optional:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Maximum size of a `tmpfs` mount in bytes. Defaults to half of the host's memory.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
 						"source": schema.StringAttribute{
-							MarkdownDescription: "Source path on the host filesystem. Must be the empty string for `tmpfs` mounts.",
-							Required:            true,
+							MarkdownDescription: "What to mount, depending on `type`: a path on the host filesystem for `bind` and `overlay` mounts, the name of a Podman volume for `volume` mounts (which is created if it does not exist, or is anonymous if this is omitted), or an image reference for `image` mounts. Must be omitted or empty for `tmpfs` and `devpts` mounts.",
+							Optional:            true,
+						},
+						"subpath": schema.StringAttribute{
+							MarkdownDescription: "Only mount this path within the volume or image. Applies to `image` and `volume` mounts.",
+							Optional:            true,
 						},
 						"target": schema.StringAttribute{
 							MarkdownDescription: "Destination path inside the container.",
 							Required:            true,
 						},
 						"type": schema.StringAttribute{
-							Computed:            true,
-							Default:             stringdefault.StaticString("bind"),
-							MarkdownDescription: "What kind of mount to create. This affects the meaning of of the other attributes in this object. If this is not specified then the default is `bind`, which is probably what you want in most cases.",
-							Optional:            true,
+							Computed: true,
+							Default:  stringdefault.StaticString("bind"),
+							MarkdownDescription: "What kind of mount to create, e.g. `bind`, `devpts`, `image`, `overlay`, `tmpfs` or `volume`. This affects the meaning of of the other attributes in this object. If this is not specified then the default is `bind`, which is probably what you want in most cases.\n\n" +
+								"  An `overlay` mount makes a host directory writable inside the container, but discards the container's changes when it is removed.\n\n" +
+								"  Other types that Podman supports, such as `ramfs`, `glob` or `artifact`, are passed to Podman as they are. They accept `source`, `target` and `options`, but none of the typed options.",
+							Optional: true,
 						},
 					},
 				},
@@ -239,17 +504,44 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"networks": schema.ListNestedAttribute{
 				MarkdownDescription: "A list of Podman networks that this container should join. If this list is omitted or empty then the container will be added to the default Podman network.\n\n" +
 					"  Podman networks allow participating containers to resolve the private IP addresses of other containers on the same network by looking up the names of those containers using DNS: this lookup is performed on just the bare name of the target container without any further qualifying domains.\n\n" +
-					"  For compatibility with Docker this DNS lookup functionality is _not_ provided on Podman's default network.",
+					"  For compatibility with Docker this DNS lookup functionality is _not_ provided on Podman's default network.\n\n" +
+					"  Networks can be added, removed or changed without replacing the container, except that switching between an empty list (i.e. the default network) and a non-empty list does replace it.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"aliases": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Additional names that other containers on this network can use to look up this container using DNS.",
+							Optional:            true,
+						},
 						"id": schema.StringAttribute{
 							MarkdownDescription: "ID of the Podman network to join.",
 							Required:            true,
 						},
+						"interface_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the network interface inside the container, e.g. `eth1`. Podman picks the next free `ethN` name by default.",
+							Optional:            true,
+						},
+						"ipv4_address": schema.StringAttribute{
+							MarkdownDescription: "Static IPv4 address to assign to this container on this network. Must be within one of the network's subnets. Podman allocates an address by default.",
+							CustomType:          iptypes.IPv4AddressType{},
+							Optional:            true,
+						},
+						"ipv6_address": schema.StringAttribute{
+							MarkdownDescription: "Static IPv6 address to assign to this container on this network. Must be within one of the network's subnets. Podman allocates an address by default.",
+							CustomType:          iptypes.IPv6AddressType{},
+							Optional:            true,
+						},
+						"mac_address": schema.StringAttribute{
+							MarkdownDescription: "Static MAC address to assign to this container's interface on this network, e.g. `92:d0:c6:0a:29:33`. Podman generates one by default.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(macAddressRegexp, "must be six colon-separated pairs of hexadecimal digits"),
+							},
+						},
 					},
 				},
 				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplaceIfConfigured(),
+					requiresReplaceIfDefaultNetworkChanged(),
 				},
 				Optional: true,
 			},
@@ -270,8 +562,8 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 							Optional:            true,
 						},
 						"host_port": schema.Int32Attribute{
-							MarkdownDescription: "Host port to bind to.",
-							Required:            true,
+							MarkdownDescription: "Host port to bind to. If this is omitted or 0 then Podman binds a free port when the container is created, which is reported by `assigned_host_ports`.",
+							Optional:            true,
 							Validators: []validator.Int32{
 								int32validator.Between(0, 65535),
 							},
@@ -286,6 +578,13 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 								),
 							},
 						},
+						"range": schema.Int32Attribute{
+							MarkdownDescription: "Number of consecutive ports to expose, starting at `container_port` and `host_port`. Defaults to 1.",
+							Optional:            true,
+							Validators: []validator.Int32{
+								int32validator.Between(1, 65535),
+							},
+						},
 					},
 				},
 				PlanModifiers: []planmodifier.List{
@@ -293,6 +592,88 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 				Optional: true,
 			},
+			"remove_volumes": schema.BoolAttribute{
+				MarkdownDescription: "Also remove this container's anonymous volumes when it is destroyed. Defaults to false.",
+				Optional:            true,
+			},
+			"resources": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"blkio_weight": schema.Int64Attribute{
+						MarkdownDescription: "Relative weight of this container's block I/O, between 10 and 1000.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(10, 1000),
+						},
+					},
+					"cpu_shares": schema.Int64Attribute{
+						MarkdownDescription: "Relative weight of this container's CPU time when the host's CPUs are contended. Containers have a weight of 1024 by default.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(2, 262144),
+						},
+					},
+					"cpus": schema.Float64Attribute{
+						MarkdownDescription: "Maximum number of CPUs that this container can use, e.g. `1.5`.",
+						Optional:            true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0.01),
+						},
+					},
+					"cpuset_cpus": schema.StringAttribute{
+						MarkdownDescription: "CPUs that this container is allowed to run on, e.g. `0-3` or `0,2`.",
+						Optional:            true,
+					},
+					"cpuset_mems": schema.StringAttribute{
+						MarkdownDescription: "NUMA memory nodes that this container is allowed to use, e.g. `0-1`.",
+						Optional:            true,
+					},
+					"memory": schema.Int64Attribute{
+						MarkdownDescription: "Memory limit in bytes.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"memory_reservation": schema.Int64Attribute{
+						MarkdownDescription: "Memory soft limit in bytes, which is enforced when the host is low on memory.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"memory_swap": schema.Int64Attribute{
+						MarkdownDescription: "Limit in bytes of this container's memory plus swap, or -1 for unlimited swap. Requires `memory` to be set.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(-1),
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("memory")),
+						},
+					},
+					"pids_limit": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of processes in this container, or -1 for unlimited.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(-1),
+						},
+					},
+					"shm_size": schema.Int64Attribute{
+						MarkdownDescription: "Size in bytes of this container's `/dev/shm`. Unlike the other limits, changing this replaces the container.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+				MarkdownDescription: "Limits on the host resources that this container can use.\n\n" +
+					"  Changes to these limits (except `shm_size`) are applied to the existing container, even while it is running. Removing a limit that was previously set replaces the container, since Podman can only change limits in place and not remove them.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Object{
+					requiresReplaceIfLimitRemoved(),
+				},
+			},
 			"restart_policy": schema.StringAttribute{
 				MarkdownDescription: "The circumstances under which this container should be automatically restarted by Podman.\n\n" +
 					"  If you want your containers to automatically start at boot time then enable the `podman-restart.service` systemd unit on the host. The podman CLI command executed by this unit will automatically start all containers that have a `restart_policy` of `always`.\n\n" +
@@ -300,15 +681,20 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 					"  - `no` (default)\n" +
 					"  - `always`\n" +
 					"  - `on-failure`\n" +
-					"  - `unless-stopped`\n",
+					"  - `unless-stopped`\n\n" +
+					"  Changing this updates the existing container if the container host runs Podman 5.0 or later, and replaces the container otherwise.",
 				Optional: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 				Validators: []validator.String{
 					stringvalidator.OneOf("no", "always", "on-failure", "unless-stopped"),
 				},
 			},
+			"restart_retries": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of times to restart the container when `restart_policy` is `on-failure`. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
 			"secrets": schema.ListNestedAttribute{
 				MarkdownDescription: "A list of Podman secrets to mount into the container's filesystem. See `uploads` below for an alternative mechanism that accomplishes a similar goal.",
 				NestedObject: schema.NestedAttributeObject{
@@ -360,6 +746,78 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 					mapplanmodifier.RequiresReplace(),
 				},
 			},
+			"security": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"apparmor_profile": schema.StringAttribute{
+						MarkdownDescription: "Name of the AppArmor profile to confine the container with, or `unconfined`. Podman applies its default profile on hosts that support AppArmor.",
+						Optional:            true,
+					},
+					"cap_add": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Linux capabilities to grant to the container in addition to Podman's defaults, e.g. `CAP_NET_ADMIN`, or `ALL`.",
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.RegexMatches(capabilityRegexp, "must be a capability name such as CAP_NET_ADMIN, or ALL")),
+						},
+					},
+					"cap_drop": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Linux capabilities to remove from the container, e.g. `CAP_CHOWN`, or `ALL` to drop every capability that is not listed in `cap_add`.",
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.RegexMatches(capabilityRegexp, "must be a capability name such as CAP_NET_ADMIN, or ALL")),
+						},
+					},
+					"masked_paths": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Additional absolute paths inside the container to hide from its processes, on top of Podman's defaults such as `/proc/kcore`.",
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.RegexMatches(absolutePathRegexp, "must be an absolute path")),
+						},
+					},
+					"no_new_privileges": schema.BoolAttribute{
+						MarkdownDescription: "Prevent the container's processes from gaining privileges that their parent did not have, e.g. through setuid binaries.",
+						Optional:            true,
+					},
+					"privileged": schema.BoolAttribute{
+						MarkdownDescription: "Run the container with all capabilities, access to all host devices and without confinement or masked paths. This turns off most of the container's isolation from the host.",
+						Optional:            true,
+					},
+					"read_only_rootfs": schema.BoolAttribute{
+						MarkdownDescription: "Mount the container's root filesystem read-only. Volumes, mounts and the tmpfs mounts controlled by `read_only_tmpfs` remain writable.",
+						Optional:            true,
+					},
+					"read_only_tmpfs": schema.BoolAttribute{
+						MarkdownDescription: "When `read_only_rootfs` is set, whether Podman mounts writable tmpfs filesystems on `/dev`, `/dev/shm`, `/run`, `/tmp` and `/var/tmp`. This mirrors Podman's `--read-only-tmpfs` option. Defaults to `true`.",
+						Optional:            true,
+						Validators: []validator.Bool{
+							boolvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("read_only_rootfs")),
+						},
+					},
+					"seccomp_profile_path": schema.StringAttribute{
+						MarkdownDescription: "Absolute path of a JSON seccomp profile on the container host, or `unconfined`. Podman applies its default profile if this is not set.\n\n" +
+							"  Podman's API reads the profile from the host's filesystem when the container is created, and has no way to receive the profile's content, so the file must be put in place by other means.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(seccompProfileRegexp, "must be an absolute path or unconfined"),
+						},
+					},
+					"unmasked_paths": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Paths that Podman masks by default which should be visible to the container, or `ALL`.",
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.RegexMatches(unmaskedPathRegexp, "must be an absolute path or ALL")),
+						},
+					},
+				},
+				MarkdownDescription: "Security settings for the container. Podman's defaults apply to any setting that is not specified.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
 			"selinux_options": schema.ListAttribute{
 				ElementType: types.StringType,
 				MarkdownDescription: "Specify SELinux labelling options for this container.\n\n" +
@@ -376,6 +834,109 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "Whether to immediately start this container after it has been created and the `uploads` attribute has been processed. Default is `true`.",
 				Optional:            true,
 			},
+			"stderr": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Standard error of the most recent run of a `job` container, if `job.capture_output` is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stdout": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Standard output of the most recent run of a `job` container, if `job.capture_output` is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stop_signal": schema.StringAttribute{
+				MarkdownDescription: "Signal that is sent to stop this container, e.g. `SIGINT`. Defaults to the image's stop signal, or `SIGTERM`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(slices.Sorted(maps.Keys(linuxSignals))...),
+				},
+			},
+			"stop_timeout": schema.Int32Attribute{
+				MarkdownDescription: "Number of seconds to wait for this container to stop after sending it `stop_signal`, before killing it. This applies whenever the container is stopped, including when it is destroyed or restarted by Podman. Defaults to 10.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"sysctls": schema.MapAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "Kernel parameters to set in the container's namespaces, e.g. `{ \"net.core.somaxconn\" = \"1024\" }`.\n\n" +
+					"  Podman only allows sysctls that belong to one of the container's own namespaces: those starting with `net.` (unless `network_namespace` shares the host's or another container's network namespace), those starting with `fs.mqueue.`, and `kernel.msgmax`, `kernel.msgmnb`, `kernel.msgmni`, `kernel.sem`, `kernel.shm_rmid_forced`, `kernel.shmall`, `kernel.shmmax` and `kernel.shmmni`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "Timezone of the container, as the name of a timezone in the IANA database such as `Europe/London`, or `local` to use the container host's timezone. Defaults to the image's timezone, which is usually UTC.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(timezoneRegexp, "must be local or the name of a timezone, e.g. Europe/London"),
+				},
+			},
+			"ulimits": schema.ListNestedAttribute{
+				MarkdownDescription: "Resource limits for the container's processes, overriding those that Podman inherits from the host or its `containers.conf`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hard": schema.Int64Attribute{
+							MarkdownDescription: "Hard limit, which an unprivileged process can not raise its soft limit beyond. -1 means unlimited.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(-1),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the limit, as used by Podman's `--ulimit` option, e.g. `nofile` or `memlock`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(linuxRlimits...),
+							},
+						},
+						"soft": schema.Int64Attribute{
+							MarkdownDescription: "Soft limit, which is enforced by the kernel. Must not be greater than `hard`. -1 means unlimited.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(-1),
+							},
+						},
+					},
+				},
+				Optional: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"umask": schema.StringAttribute{
+				MarkdownDescription: "Octal file mode creation mask of the container's processes, e.g. `0027`. Defaults to `0022`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(umaskRegexp, "must be an octal number of up to four digits"),
+				},
+			},
+			"unsetenv": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of environment variables to remove from the container's environment, e.g. variables that are set by the image or by Podman's defaults such as `container` and `HOME`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
 			"uploads": schema.ListNestedAttribute{
 				MarkdownDescription: "A list of files to upload to this container. Files uploaded during container creation will be uploaded before the container is started, if applicable. Changes to this attribute will result in the changed files being re-uploaded to the existing container.\n\n" +
 					"  File content is not stored as part of Terraform state, so this mechanism can be used to supply secret data to the container such as private keys. However, it should only be used to upload small files, like secrets or configuration.",
@@ -446,6 +1007,285 @@ func (*containerResource) Schema(ctx context.Context, req resource.SchemaRequest
 					objectplanmodifier.RequiresReplace(),
 				},
 			},
+			"volumes_from": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names or IDs of other containers whose volumes and mounts are also mounted into this container, at the same paths. A container may be followed by `:ro` or `:rw` to override whether its mounts are read-only.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(volumesFromRegexp, "must be a container name or ID, optionally followed by :ro or :rw")),
+				},
+			},
+			"wait_for": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"log_pattern": schema.StringAttribute{
+						MarkdownDescription: "Wait until the container is running and has written a line matching this regular expression to its standard output or standard error.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("state")),
+						},
+					},
+					"state": schema.StringAttribute{
+						MarkdownDescription: "Wait until the container is `running`, or until it is running and its health check reports that it is `healthy`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("running", "healthy"),
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("log_pattern")),
+						},
+					},
+					"timeout": schema.Int32Attribute{
+						MarkdownDescription: "Maximum number of seconds to wait. Defaults to 60.",
+						Optional:            true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+				},
+				MarkdownDescription: "Wait for the container to become ready after starting it, so that resources that depend on this container are not created until it is ready. If the container exits, or does not become ready in time, then the error includes its most recent health check results and log output, and the container is marked as tainted.",
+				Optional:            true,
+			},
+			"working_dir": schema.StringAttribute{
+				MarkdownDescription: "Directory inside the container that its command is started in. Defaults to the image's working directory.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(absolutePathRegexp, "must be an absolute path"),
+				},
+			},
 		},
 	}
 }
+
+// The log file options only apply to the `k8s-file` driver, and `none` and `passthrough` take no
+// options at all. A null driver is allowed since the host's default is not known at plan time.
+func validateLogging(ctx context.Context, driver *types.String, in *types.Object) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if driver.IsNull() || driver.IsUnknown() || in.IsNull() || in.IsUnknown() {
+		return result
+	}
+
+	var model containerResourceLogOptionsModel
+	result.Append(in.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	if result.HasError() {
+		return result
+	}
+
+	options := map[string]attr.Value{
+		"max_size": model.MaxSize,
+		"path":     model.Path,
+		"tag":      model.Tag,
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(options)) {
+		if options[name].IsNull() {
+			continue
+		}
+
+		supported := driver.ValueString() == "k8s-file" || (name == "tag" && driver.ValueString() == "journald")
+
+		if !supported {
+			result.AddAttributeError(
+				path.Root("log_options").AtName(name),
+				"Invalid attribute combination",
+				fmt.Sprintf("log_options.%s is not supported by the %s log driver", name, driver.ValueString()))
+		}
+	}
+
+	return result
+}
+
+// Reports whether any port mapping binds a specific host port, as opposed to one picked by Podman.
+func hasFixedHostPort(ctx context.Context, in types.List) bool {
+	models := make([]containerResourcePortMappingModel, 0)
+	in.ElementsAs(ctx, &models, false)
+
+	return slices.ContainsFunc(models, func(model containerResourcePortMappingModel) bool {
+		return model.HostPort.IsUnknown() || model.HostPort.ValueInt32() != 0
+	})
+}
+
+var (
+	absolutePathRegexp   = regexp.MustCompile(`^/`)
+	capabilityRegexp     = regexp.MustCompile(`^(ALL|(CAP_)?[A-Z_]+)$`)
+	hostnameRegexp       = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?)*$`)
+	macAddressRegexp     = regexp.MustCompile(`^[0-9A-Fa-f]{2}(:[0-9A-Fa-f]{2}){5}$`)
+	seccompProfileRegexp = regexp.MustCompile(`^(/|unconfined$)`)
+	timezoneRegexp       = regexp.MustCompile(`^(local|[A-Za-z0-9_+-]+(/[A-Za-z0-9_+-]+)*)$`)
+	umaskRegexp          = regexp.MustCompile(`^[0-7]{1,4}$`)
+	unmaskedPathRegexp   = regexp.MustCompile(`^(/|ALL$)`)
+	volumesFromRegexp    = regexp.MustCompile(`^[^:]+(:(ro|rw))?$`)
+)
+
+func (*containerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data containerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RestartRetries.IsNull() && !data.RestartPolicy.IsUnknown() && data.RestartPolicy.ValueString() != "on-failure" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("restart_retries"),
+			"Invalid attribute combination",
+			"restart_retries can only be set when restart_policy is \"on-failure\"")
+	}
+
+	if !data.Job.IsNull() && !data.RestartPolicy.IsUnknown() && slices.Contains([]string{"always", "unless-stopped"}, data.RestartPolicy.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("restart_policy"),
+			"Invalid attribute combination",
+			"restart_policy can not be \"always\" or \"unless-stopped\" when job is set")
+	}
+
+	if data.BlueGreen.ValueBool() {
+		if data.Name.IsNull() || data.WaitFor.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("blue_green"),
+				"Invalid attribute combination",
+				"blue_green can only be set when name and wait_for are set")
+		}
+
+		if hasFixedHostPort(ctx, data.PortMappings) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("blue_green"),
+				"Invalid attribute combination",
+				"blue_green can not be set when port_mappings sets a host_port, because the old and new containers would need to bind the same host ports at the same time")
+		}
+	}
+
+	portMappings := make([]containerResourcePortMappingModel, 0)
+
+	if !data.PortMappings.IsUnknown() {
+		resp.Diagnostics.Append(data.PortMappings.ElementsAs(ctx, &portMappings, false)...)
+	}
+
+	for i, model := range portMappings {
+		last := max(model.ContainerPort.ValueInt32(), model.HostPort.ValueInt32()) + model.Range.ValueInt32() - 1
+
+		if !model.Range.IsNull() && !model.Range.IsUnknown() && last > 65535 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("port_mappings").AtListIndex(i).AtName("range"),
+				"Invalid port range",
+				fmt.Sprintf("the range of ports ends at %d, which is greater than 65535", last))
+		}
+	}
+
+	resp.Diagnostics.Append(validateLogging(ctx, &data.LogDriver, &data.LogOptions)...)
+	resp.Diagnostics.Append(validateMounts(ctx, &data.Mounts)...)
+	resp.Diagnostics.Append(validateSysctls(ctx, &data.Sysctls, &data.NetworkNamespace)...)
+
+	ulimits := make([]containerResourceUlimitModel, 0)
+
+	if !data.Ulimits.IsUnknown() {
+		resp.Diagnostics.Append(data.Ulimits.ElementsAs(ctx, &ulimits, false)...)
+	}
+
+	ulimitNames := make(map[string]bool)
+
+	for i, model := range ulimits {
+		if !model.Name.IsUnknown() && ulimitNames[model.Name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ulimits").AtListIndex(i).AtName("name"),
+				"Duplicate ulimit",
+				fmt.Sprintf("%s is set more than once", model.Name.ValueString()))
+		}
+
+		ulimitNames[model.Name.ValueString()] = true
+		soft := model.Soft.ValueInt64()
+		hard := model.Hard.ValueInt64()
+		known := !model.Soft.IsUnknown() && !model.Hard.IsUnknown()
+
+		if known && hard >= 0 && (soft < 0 || soft > hard) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ulimits").AtListIndex(i).AtName("soft"),
+				"Invalid ulimit",
+				fmt.Sprintf("the soft limit for %s must not be greater than the hard limit", model.Name.ValueString()))
+		}
+	}
+
+	if data.WaitFor.IsNull() || data.WaitFor.IsUnknown() {
+		return
+	}
+
+	notStarted := !data.StartImmediately.IsNull() && !data.StartImmediately.IsUnknown() && !data.StartImmediately.ValueBool()
+	notRunning := !data.DesiredState.IsNull() && !data.DesiredState.IsUnknown() && data.DesiredState.ValueString() != "running"
+
+	if notStarted || notRunning {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for"),
+			"Invalid attribute combination",
+			"wait_for can only be set when the container is started, i.e. when desired_state is \"running\" or start_immediately is true")
+	}
+
+	var waitFor containerResourceWaitForModel
+	resp.Diagnostics.Append(data.WaitFor.As(ctx, &waitFor, basetypes.ObjectAsOptions{})...)
+
+	if resp.Diagnostics.HasError() || waitFor.LogPattern.IsNull() || waitFor.LogPattern.IsUnknown() {
+		return
+	}
+
+	_, err := regexp.Compile(waitFor.LogPattern.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_for").AtName("log_pattern"), "Invalid regular expression", err.Error())
+	}
+
+	if slices.Contains([]string{"none", "passthrough"}, data.LogDriver.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for").AtName("log_pattern"),
+			"Invalid attribute combination",
+			"wait_for.log_pattern can not be set when log_driver is \"none\" or \"passthrough\", because Podman does not keep the container's logs")
+	}
+}
+
+// Podman's update endpoint only changes the limits that are present in the request, so a limit
+// that was previously set can't be removed from an existing container.
+func requiresReplaceIfLimitRemoved() planmodifier.Object {
+	return objectplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+			if req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+				return
+			}
+
+			planAttrs := req.PlanValue.Attributes()
+
+			for name, value := range req.StateValue.Attributes() {
+				if value.IsNull() {
+					continue
+				}
+
+				if req.PlanValue.IsNull() || planAttrs[name].IsNull() {
+					resp.RequiresReplace = true
+
+					return
+				}
+			}
+		},
+		"Removing a resource limit requires the container to be replaced.",
+		"Removing a resource limit requires the container to be replaced.",
+	)
+}
+
+// A container that is created without any networks joins Podman's default network instead, which
+// connecting and disconnecting networks later on would not account for. Only changes from one
+// non-empty list of networks to another are applied to an existing container.
+func requiresReplaceIfDefaultNetworkChanged() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			if req.StateValue.IsNull() && req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+				return
+			}
+
+			resp.RequiresReplace = (len(req.StateValue.Elements()) == 0) != (len(req.PlanValue.Elements()) == 0)
+		},
+		"Switching between the default network and a list of networks requires the container to be replaced.",
+		"Switching between the default network and a list of networks requires the container to be replaced.",
+	)
+}
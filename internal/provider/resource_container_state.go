@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Maps a container's libpod status onto the values of the `desired_state` attribute. The
+// `stopped` and `created` states are interchangeable, so whichever one is desired is reported for
+// any container that isn't running.
+func observedState(desired string, status string) string {
+	switch status {
+	case "running", "paused":
+		return status
+	default:
+		if desired == "created" {
+			return desired
+		}
+
+		return "stopped"
+	}
+}
+
+// Starts, stops, pauses or unpauses a container until it reaches the desired state.
+func reconcileState(ctx context.Context, c *client.Client, nameOrId string, desired string) error {
+	json, err := c.ContainerInspect(ctx, nameOrId)
+
+	if err != nil {
+		return err
+	}
+
+	actual := observedState(desired, json.State.Status)
+
+	if actual == desired {
+		return nil
+	}
+
+	tflog.Trace(ctx, "Changing container state", map[string]any{
+		"id":  nameOrId,
+		"old": actual,
+		"new": desired,
+	})
+
+	switch desired {
+	case "running":
+		if actual == "paused" {
+			return c.ContainerUnpause(ctx, nameOrId)
+		}
+
+		return c.ContainerStart(ctx, nameOrId)
+
+	case "paused":
+		if actual != "running" {
+			err = c.ContainerStart(ctx, nameOrId)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return c.ContainerPause(ctx, nameOrId)
+
+	default:
+		return c.ContainerStop(ctx, nameOrId, nil)
+	}
+}
+
+// Waits for a container that has just been started to satisfy its `wait_for` attribute, if set.
+func waitForStartup(ctx context.Context, c *client.Client, nameOrId string, in *types.Object) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if in.IsNull() {
+		return result
+	}
+
+	var model containerResourceWaitForModel
+	result.Append(in.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	if result.HasError() {
+		return result
+	}
+
+	var logPattern *regexp.Regexp
+
+	if !model.LogPattern.IsNull() {
+		var err error
+		logPattern, err = regexp.Compile(model.LogPattern.ValueString())
+
+		if err != nil {
+			result.AddAttributeError(path.Root("wait_for").AtName("log_pattern"), "Invalid regular expression", err.Error())
+
+			return result
+		}
+	}
+
+	timeout := 60 * time.Second
+
+	if !model.Timeout.IsNull() {
+		timeout = time.Duration(model.Timeout.ValueInt32()) * time.Second
+	}
+
+	tflog.Trace(ctx, "Waiting for container", map[string]any{"id": nameOrId})
+	err := waitForContainer(ctx, c, nameOrId, model.State.ValueString() == "healthy", logPattern, timeout)
+
+	if err != nil {
+		result.AddAttributeError(
+			path.Root("wait_for"),
+			"Container did not become ready",
+			err.Error()+containerFailureDetails(ctx, c, nameOrId))
+	}
+
+	return result
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -55,6 +57,56 @@ func (co *containerResource) Update(ctx context.Context, req resource.UpdateRequ
 		})
 	}
 
+	// Containers that were moved from the Docker provider don't have this yet
+	if newData.AssignedHostPorts.IsUnknown() {
+		resp.Diagnostics.Append(readHostPorts(ctx, c, id, &newData.PortMappings, &newData.AssignedHostPorts)...)
+	}
+
+	if !newData.Networks.Equal(oldData.Networks) {
+		resp.Diagnostics.Append(updateNetworks(ctx, c, id, oldData.Networks, newData.Networks)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Removing a limit replaces the container, see requiresReplaceIfLimitRemoved()
+	var limits *api.ContainerCreateResourceLimitsJson
+
+	if !newData.Resources.IsNull() && !newData.Resources.Equal(oldData.Resources) {
+		var shmSize *int64
+		resp.Diagnostics.Append(writeResources(ctx, &newData.Resources, &limits, &shmSize)...)
+	}
+
+	// Hosts that can't change the restart policy in place are handled by planRestartPolicy()
+	var restart *client.ContainerRestartPolicy
+
+	if !newData.RestartPolicy.Equal(oldData.RestartPolicy) || !newData.RestartRetries.Equal(oldData.RestartRetries) {
+		restart = &client.ContainerRestartPolicy{Policy: "no"}
+
+		if !newData.RestartPolicy.IsNull() {
+			restart.Policy = newData.RestartPolicy.ValueString()
+		}
+
+		resp.Diagnostics.Append(writeRestartRetries(&newData.RestartRetries, &restart.Retries)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if limits != nil || restart != nil {
+		err = c.ContainerUpdate(ctx, id, limits, restart)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating container", err.Error())
+
+			return
+		}
+
+		tflog.Trace(ctx, "Updated container", map[string]any{"id": id})
+	}
+
 	// See docs for diffUpload(), this is a bit fiddly.
 
 	var uploads []*containerResourceUploadModel
@@ -98,7 +150,34 @@ func (co *containerResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 	}
 
+	// Read reports the container's actual state, so this also restarts a container that has
+	// crashed. It is done last so that uploads happen before the container is started, as in Create.
+	if !newData.DesiredState.IsNull() && !newData.DesiredState.Equal(oldData.DesiredState) {
+		err = reconcileState(ctx, c, id, newData.DesiredState.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("desired_state"), "Container state change failed", err.Error())
+
+			return
+		}
+
+		if newData.DesiredState.ValueString() == "running" {
+			resp.Diagnostics.Append(waitForStartup(ctx, c, id, &newData.WaitFor)...)
+		}
+	}
+
+	if jobTriggersChanged(oldData.Job, newData.Job) {
+		d := runJob(ctx, c, &newData)
+		resp.Diagnostics.Append(d...)
+
+		// Keep the old triggers so that the job is run again by the next apply
+		if d.HasError() {
+			newData.Job = oldData.Job
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newData)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, newData.Id, newData.ContainerHost)...)
 }
 
 // A slightly fiddly diffing algorithm for the contents of the `uploads` list attribute. The `out`
@@ -6,6 +6,7 @@ import (
 	"github.com/decafcode/terraform-provider-podman/internal/api"
 	"github.com/decafcode/terraform-provider-podman/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -22,6 +23,10 @@ func newImageResource() resource.Resource {
 	return &imageResource{}
 }
 
+func newImageListResource() list.ListResource {
+	return &imageResource{}
+}
+
 type imageResource struct {
 	resourceBase
 }
@@ -215,6 +220,7 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Id, data.ContainerHost)...)
 }
 
 func (r *imageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -267,7 +273,7 @@ func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	_, err = c.ImageInspect(ctx, data.Id.ValueString())
+	json, err := c.ImageInspect(ctx, data.Id.ValueString())
 
 	if err != nil {
 		status, ok := err.(client.StatusCodeError)
@@ -280,6 +286,60 @@ func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 		return
 	}
+
+	// `reference` is only null if this image is being imported. Images that were pulled by
+	// digest alone have no tags, in which case the reference has to be set by hand.
+	if data.Reference.IsNull() && len(json.RepoTags) > 0 {
+		data.Reference = types.StringValue(json.RepoTags[0])
+	}
+
+	data.Id = types.StringValue(json.Id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Id, data.ContainerHost)...)
+}
+
+func (r *imageResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listConfigSchema("image", "Only list images with a matching reference, using the same syntax as `podman images --filter reference=...`. For example `nginx` matches any tag of `docker.io/library/nginx`.")
+}
+
+// Images without tags are listed by ID. Their `reference` is left null, as it is on import.
+func (r *imageResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	c, data, filters, diags := r.beginList(ctx, req, "reference")
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	images, err := c.ImageList(ctx, filters)
+
+	if err != nil {
+		diags.AddError("Error listing images", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	items := make([]listItem, 0, len(images))
+
+	for _, img := range images {
+		item := listItem{
+			attributes: map[string]any{},
+			id:         img.Id,
+			name:       img.Id,
+		}
+
+		if len(img.RepoTags) > 0 {
+			item.attributes["reference"] = img.RepoTags[0]
+			item.name = img.RepoTags[0]
+		}
+
+		items = append(items, item)
+	}
+
+	stream.Results = listResults(ctx, req, data, items)
 }
 
 func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -291,4 +351,43 @@ func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newData)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, newData.Id, newData.ContainerHost)...)
+}
+
+func (r *imageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp)
+}
+
+type dockerImageState struct {
+	ImageId     string `json:"image_id"`
+	KeepLocally bool   `json:"keep_locally"`
+	Name        string `json:"name"`
+}
+
+func (r *imageResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: moveDockerImage,
+		},
+	}
+}
+
+func moveDockerImage(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	var in dockerImageState
+	raw, diags := decodeDockerState(req, "docker_image", &in)
+	resp.Diagnostics.Append(diags...)
+
+	if raw == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), trimDockerImageId(in.ImageId))...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("reference"), in.Name)...)
+
+	if in.KeepLocally {
+		resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("preserve"), true)...)
+	}
+
+	resp.Diagnostics.Append(warnUntranslated("docker_image", dockerUntranslated(raw,
+		"build", "force_remove", "platform", "pull_triggers", "triggers"))...)
 }
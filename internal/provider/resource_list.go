@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"iter"
+	"strings"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Support for `list` blocks, which are used by `terraform query` to enumerate the objects on a
+// container host so that they can be imported. Each resource type implements the list resource
+// interface alongside the managed resource interface, and they share a common configuration
+// schema.
+
+type listConfigModel struct {
+	ContainerHost types.String `tfsdk:"container_host"`
+	Labels        types.Map    `tfsdk:"labels"`
+	Name          types.String `tfsdk:"name"`
+}
+
+// A listed object. `attributes` are written into the listed resource's state when Terraform asks
+// for the full resource, in addition to `id` and `container_host`.
+type listItem struct {
+	attributes map[string]any
+	id         string
+	name       string
+}
+
+func listConfigSchema(kind string, nameDescription string) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Lists the " + kind + "s on a container host",
+
+		Attributes: map[string]schema.Attribute{
+			"container_host": schema.StringAttribute{
+				MarkdownDescription: "URL of the container host to list " + kind + "s on",
+				Optional:            true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only list " + kind + "s that have all of these labels. An empty value matches any value of the label.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: nameDescription,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Reads the list block's configuration, connects to its container host and translates it into
+// libpod list filters. `nameFilter` is the libpod filter that the `name` attribute maps to.
+func (r *resourceBase) beginList(ctx context.Context, req list.ListRequest, nameFilter string) (*client.Client, *listConfigModel, api.ListFilters, diag.Diagnostics) {
+	var result diag.Diagnostics
+	var data listConfigModel
+	result.Append(req.Config.Get(ctx, &data)...)
+
+	if result.HasError() {
+		return nil, nil, nil, result
+	}
+
+	c, err := r.ps.getClient(ctx, data.ContainerHost.ValueString())
+
+	if err != nil {
+		result.AddError("Connection Error", err.Error())
+
+		return nil, nil, nil, result
+	}
+
+	filters := make(api.ListFilters)
+
+	if !data.Name.IsNull() {
+		filters[nameFilter] = []string{data.Name.ValueString()}
+	}
+
+	result.Append(addLabelFilters(ctx, filters, data.Labels)...)
+
+	return c, &data, filters, result
+}
+
+// Objects must have all of the given labels. An empty value matches any value of the label.
+func addLabelFilters(ctx context.Context, filters api.ListFilters, in types.Map) diag.Diagnostics {
+	var labels map[string]string
+	result := in.ElementsAs(ctx, &labels, false)
+
+	for key, value := range labels {
+		if value == "" {
+			filters["label"] = append(filters["label"], key)
+		} else {
+			filters["label"] = append(filters["label"], key+"="+value)
+		}
+	}
+
+	return result
+}
+
+func listResults(ctx context.Context, req list.ListRequest, data *listConfigModel, items []listItem) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.name
+			id := types.StringValue(item.id)
+			result.Diagnostics.Append(setIdentity(ctx, result.Identity, id, data.ContainerHost)...)
+
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), id)...)
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("container_host"), data.ContainerHost)...)
+
+				for name, value := range item.attributes {
+					result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(name), value)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// The name to show for an object in diagnostics and list results: its first name, without the
+// leading slash of Docker-style container names, or its ID if it has no names.
+func displayName(names []string, id string) string {
+	if len(names) > 0 {
+		return strings.TrimPrefix(names[0], "/")
+	}
+
+	return id
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/decafcode/terraform-provider-podman/internal/api"
 	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -19,6 +20,10 @@ func newNetworkResource() resource.Resource {
 	return &networkResource{}
 }
 
+func newNetworkListResource() list.ListResource {
+	return &networkResource{}
+}
+
 type networkResource struct {
 	resourceBase
 }
@@ -124,6 +129,7 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 	data.Id = types.StringValue(out.Id)
 	tflog.Trace(ctx, "Network created", map[string]any{"id": data.Id})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Id, data.ContainerHost)...)
 }
 
 func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -159,9 +165,51 @@ func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, re
 	data.DnsEnabled = types.BoolValue(json.DnsEnabled)
 	data.Internal = types.BoolValue(json.Internal)
 	data.Ipv6Enabled = types.BoolValue(json.Ipv6Enabled)
+	data.Id = types.StringValue(json.Id)
 	data.Name = types.StringValue(json.Name)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Id, data.ContainerHost)...)
+}
+
+func (r *networkResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listConfigSchema("network", "Only list networks whose name matches this regular expression.")
+}
+
+func (r *networkResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	c, data, filters, diags := r.beginList(ctx, req, "name")
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	networks, err := c.NetworkList(ctx, filters)
+
+	if err != nil {
+		diags.AddError("Error listing networks", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	items := make([]listItem, 0, len(networks))
+
+	for _, n := range networks {
+		items = append(items, listItem{
+			attributes: map[string]any{
+				"dns_enabled":  n.DnsEnabled,
+				"internal":     n.Internal,
+				"ipv6_enabled": n.Ipv6Enabled,
+				"name":         n.Name,
+			},
+			id:   n.Id,
+			name: n.Name,
+		})
+	}
+
+	stream.Results = listResults(ctx, req, data, items)
 }
 
 func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -196,3 +244,51 @@ func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest
 func (r *networkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	importState(ctx, req, resp)
 }
+
+type dockerNetworkState struct {
+	Id       string `json:"id"`
+	Internal bool   `json:"internal"`
+	Ipv6     bool   `json:"ipv6"`
+	Name     string `json:"name"`
+}
+
+func (r *networkResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: moveDockerNetwork,
+		},
+	}
+}
+
+// Docker always provides DNS resolution on user-defined networks, so `dns_enabled` is set here to
+// match. The next refresh will correct it if the network on the Podman host differs. There are no
+// subnet or gateway attributes to translate `ipam_config` into, so it is reported as untranslated
+// along with the other settings that are dropped.
+func moveDockerNetwork(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	var in dockerNetworkState
+	raw, diags := decodeDockerState(req, "docker_network", &in)
+	resp.Diagnostics.Append(diags...)
+
+	if raw == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := networkResourceModel{
+		ContainerHost: types.StringNull(),
+		DnsEnabled:    types.BoolValue(true),
+		Id:            types.StringValue(in.Id),
+		Internal:      types.BoolValue(in.Internal),
+		Ipv6Enabled:   types.BoolValue(in.Ipv6),
+		Name:          types.StringValue(in.Name),
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+
+	untranslated := dockerUntranslated(raw, "attachable", "ingress", "ipam_config", "ipam_options", "labels", "options")
+
+	if driver := string(raw["driver"]); driver != "" && driver != `"bridge"` && driver != "null" {
+		untranslated = append(untranslated, "driver")
+	}
+
+	resp.Diagnostics.Append(warnUntranslated("docker_network", untranslated)...)
+}
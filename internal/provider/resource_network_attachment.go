@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func newNetworkAttachmentResource() resource.Resource {
+	return &networkAttachmentResource{}
+}
+
+func newNetworkAttachmentListResource() list.ListResource {
+	return &networkAttachmentResource{}
+}
+
+type networkAttachmentResource struct {
+	resourceBase
+}
+
+type networkAttachmentResourceModel struct {
+	Aliases       types.List          `tfsdk:"aliases"`
+	Container     types.String        `tfsdk:"container"`
+	ContainerHost types.String        `tfsdk:"container_host"`
+	Id            types.String        `tfsdk:"id"`
+	InterfaceName types.String        `tfsdk:"interface_name"`
+	Ipv4Address   iptypes.IPv4Address `tfsdk:"ipv4_address"`
+	Ipv6Address   iptypes.IPv6Address `tfsdk:"ipv6_address"`
+	MacAddress    types.String        `tfsdk:"mac_address"`
+	Network       types.String        `tfsdk:"network"`
+}
+
+func (r *networkAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_attachment"
+}
+
+func (r *networkAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Connects an existing container to a Podman network, e.g. a container that is managed by another Terraform configuration. " +
+			"Containers that are managed by a `podman_container` resource in the same configuration should normally use its `networks` attribute instead, and the same network should not be attached both ways.\n\n" +
+			"If the container is replaced then it loses this attachment, so the replacement is connected again by the next apply. Referring to the container by its `id` attribute ensures that this happens in the same apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"aliases": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Additional names that other containers on this network can use to look up the container using DNS.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"container": schema.StringAttribute{
+				MarkdownDescription: "ID or name of the container to connect.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"container_host": schema.StringAttribute{
+				MarkdownDescription: "URL of the container host where this resource resides",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The `container` attribute and the network's name, separated by a slash.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the network interface inside the container, e.g. `eth1`. Podman picks the next free `ethN` name by default.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipv4_address": schema.StringAttribute{
+				CustomType:          iptypes.IPv4AddressType{},
+				MarkdownDescription: "Static IPv4 address to assign to the container on this network. Must be within one of the network's subnets. Podman allocates an address by default.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipv6_address": schema.StringAttribute{
+				CustomType:          iptypes.IPv6AddressType{},
+				MarkdownDescription: "Static IPv6 address to assign to the container on this network. Must be within one of the network's subnets. Podman allocates an address by default.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mac_address": schema.StringAttribute{
+				MarkdownDescription: "Static MAC address to assign to the container's interface on this network, e.g. `92:d0:c6:0a:29:33`. Podman generates one by default.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(macAddressRegexp, "must be six colon-separated pairs of hexadecimal digits"),
+				},
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "ID or name of the network to connect the container to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *networkAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data networkAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := r.ps.getClient(ctx, data.ContainerHost.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Connection Error", err.Error())
+
+		return
+	}
+
+	options, d := networkOptions(ctx, &containerResourceNetworkModel{
+		Aliases:       data.Aliases,
+		Id:            data.Network,
+		InterfaceName: data.InterfaceName,
+		Ipv4Address:   data.Ipv4Address,
+		Ipv6Address:   data.Ipv6Address,
+		MacAddress:    data.MacAddress,
+	})
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = c.NetworkConnect(ctx, data.Network.ValueString(), &api.NetworkConnectJson{
+		Container:                  data.Container.ValueString(),
+		ContainerCreateNetworkJson: options,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error connecting network", err.Error())
+
+		return
+	}
+
+	found, err := inspectAttachment(ctx, c, &data)
+
+	if err == nil && !found {
+		err = fmt.Errorf("container %s is not connected to network %s", data.Container.ValueString(), data.Network.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error inspecting network attachment", err.Error())
+
+		return
+	}
+
+	tflog.Trace(ctx, "Network attached", map[string]any{"id": data.Id})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Id, data.ContainerHost)...)
+}
+
+// Checks that the container is still connected to the network and sets the attachment's ID to the
+// configured container reference and the network's name. A container's networks are keyed by name
+// in libpod's inspect output, whereas `network` may also be an ID. The container's own ID is not
+// used, since it changes whenever a container that is referred to by name is recreated, and the
+// resource's identity must not change on refresh.
+func inspectAttachment(ctx context.Context, c *client.Client, data *networkAttachmentResourceModel) (bool, error) {
+	network, err := c.NetworkInspect(ctx, data.Network.ValueString())
+
+	if isNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	container, err := c.ContainerInspect(ctx, data.Container.ValueString())
+
+	if isNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if _, ok := container.NetworkSettings.Networks[network.Name]; !ok {
+		return false, nil
+	}
+
+	data.Id = types.StringValue(data.Container.ValueString() + "/" + network.Name)
+
+	return true, nil
+}
+
+func (r *networkAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data networkAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Podman does not report the options that a container was connected to a network with, so an
+	// imported attachment only has the container and the network, which are taken from its ID.
+	if data.Container.IsNull() || data.Network.IsNull() {
+		container, network, ok := strings.Cut(data.Id.ValueString(), "/")
+
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Invalid network attachment ID",
+				fmt.Sprintf("expected <container>/<network>, got %q", data.Id.ValueString()))
+
+			return
+		}
+
+		data.Container = types.StringValue(container)
+		data.Network = types.StringValue(network)
+	}
+
+	c, err := r.ps.getClient(ctx, data.ContainerHost.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Connection Error", err.Error())
+
+		return
+	}
+
+	found, err := inspectAttachment(ctx, c, &data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error inspecting network attachment", err.Error())
+
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Id, data.ContainerHost)...)
+}
+
+func (r *networkAttachmentResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listConfigSchema("network attachment", "Only list the network attachments of containers whose name matches this regular expression.")
+}
+
+// Lists every network that each matching container is connected to, including networks that it
+// was connected to when it was created. `labels` match the container's labels.
+func (r *networkAttachmentResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	c, data, filters, diags := r.beginList(ctx, req, "name")
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	containers, err := c.ContainerList(ctx, filters)
+
+	if err != nil {
+		diags.AddError("Error listing containers", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	items := make([]listItem, 0)
+
+	for _, container := range containers {
+		name := displayName(container.Names, container.Id)
+
+		for _, network := range container.Networks {
+			items = append(items, listItem{
+				attributes: map[string]any{
+					"container": name,
+					"network":   network,
+				},
+				id:   name + "/" + network,
+				name: name + "/" + network,
+			})
+		}
+	}
+
+	stream.Results = listResults(ctx, req, data, items)
+}
+
+func (r *networkAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Resource is immutable", "Resource is immutable")
+}
+
+func (r *networkAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data networkAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := r.ps.getClient(ctx, data.ContainerHost.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Connection Error", err.Error())
+
+		return
+	}
+
+	err = c.NetworkDisconnect(ctx, data.Network.ValueString(), &api.NetworkDisconnectJson{
+		Container: data.Container.ValueString(),
+	})
+
+	// The attachment is already gone if either the container or the network has been deleted
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Error disconnecting network", err.Error())
+
+		return
+	}
+}
+
+func (r *networkAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp)
+}
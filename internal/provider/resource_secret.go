@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/decafcode/terraform-provider-podman/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,10 @@ func newSecretResource() resource.Resource {
 	return &secretResource{}
 }
 
+func newSecretListResource() list.ListResource {
+	return &secretResource{}
+}
+
 type secretResource struct {
 	resourceBase
 }
@@ -112,6 +117,7 @@ func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.Id = types.StringValue(out.Id)
 	tflog.Trace(ctx, "Secret created", map[string]any{"id": data.Id})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Id, data.ContainerHost)...)
 }
 
 func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -144,9 +150,50 @@ func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	data.Id = types.StringValue(json.Id)
 	data.Name = types.StringValue(json.Spec.Name)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.Id, data.ContainerHost)...)
+}
+
+func (r *secretResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listConfigSchema("secret", "Only list secrets whose name matches this regular expression.")
+}
+
+// As with imported secrets, listed secrets have a `value_version` of 1.
+func (r *secretResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	c, data, filters, diags := r.beginList(ctx, req, "name")
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	secrets, err := c.SecretList(ctx, filters)
+
+	if err != nil {
+		diags.AddError("Error listing secrets", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	items := make([]listItem, 0, len(secrets))
+
+	for _, secret := range secrets {
+		items = append(items, listItem{
+			attributes: map[string]any{
+				"name":          secret.Spec.Name,
+				"value_version": 1,
+			},
+			id:   secret.Id,
+			name: secret.Spec.Name,
+		})
+	}
+
+	stream.Results = listResults(ctx, req, data, items)
 }
 
 func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -0,0 +1,23 @@
+package provider
+
+// Resource limits that can be set with `ulimits`, using the names of Podman's `--ulimit` option.
+// Libpod passes limits through to the OCI runtime, which expects them to be named like
+// `RLIMIT_NOFILE`.
+var linuxRlimits = []string{
+	"as",
+	"core",
+	"cpu",
+	"data",
+	"fsize",
+	"locks",
+	"memlock",
+	"msgqueue",
+	"nice",
+	"nofile",
+	"nproc",
+	"rss",
+	"rtprio",
+	"rttime",
+	"sigpending",
+	"stack",
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Podman only allows sysctls that are scoped to one of the container's own namespaces, so that a
+// container can not change settings for the whole host. These are the IPC namespace's sysctls.
+var ipcSysctls = []string{
+	"kernel.msgmax",
+	"kernel.msgmnb",
+	"kernel.msgmni",
+	"kernel.sem",
+	"kernel.shm_rmid_forced",
+	"kernel.shmall",
+	"kernel.shmmax",
+	"kernel.shmmni",
+}
+
+const ipcSysctlPrefix = "fs.mqueue."
+const netSysctlPrefix = "net."
+
+// Checks that each sysctl is one that Podman accepts, and that it belongs to a namespace that the
+// container does not share. The container always has its own IPC namespace, but its network
+// namespace may be the host's or another container's.
+func validateSysctls(ctx context.Context, in *types.Map, netns *types.Object) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	if in.IsNull() || in.IsUnknown() {
+		return result
+	}
+
+	var sharedNetwork string
+
+	if !netns.IsNull() && !netns.IsUnknown() {
+		var model containerResourceNamespaceModel
+		result.Append(netns.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+		if mode := model.Mode.ValueString(); mode == "host" || mode == "container" {
+			sharedNetwork = mode
+		}
+	}
+
+	for key := range in.Elements() {
+		attr := path.Root("sysctls").AtMapKey(key)
+
+		switch {
+		case slices.Contains(ipcSysctls, key) || strings.HasPrefix(key, ipcSysctlPrefix):
+		case strings.HasPrefix(key, netSysctlPrefix):
+			if sharedNetwork != "" {
+				result.AddAttributeError(
+					attr,
+					"Invalid attribute combination",
+					fmt.Sprintf("%s can not be set when network_namespace.mode is %q, because the network namespace is shared", key, sharedNetwork))
+			}
+		default:
+			result.AddAttributeError(
+				attr,
+				"Unsupported sysctl",
+				fmt.Sprintf("%s is not namespaced, so Podman does not allow it to be set for a container. Only sysctls starting with %s or %s and the kernel.msg*, kernel.sem and kernel.shm* sysctls can be set.", key, netSysctlPrefix, ipcSysctlPrefix))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"gotest.tools/v3/assert"
+)
+
+func TestAccContainerRestartAction(t *testing.T) {
+	web := &testutil.TestContainer{
+		Health:  "healthy",
+		Id:      "webid",
+		Json:    api.ContainerCreateJson{Name: "web"},
+		Running: true,
+	}
+
+	apiServer := testutil.ApiServer{
+		Containers: []*testutil.TestContainer{web},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(trigger string, action string) string {
+		return fmt.Sprintf(`
+			provider "podman" {
+				container_host = "%s"
+			}
+
+			action "podman_container_restart" "web" {
+				config {
+					container = "web"
+					%s
+				}
+			}
+
+			resource "terraform_data" "certificate" {
+				input = "%s"
+
+				lifecycle {
+					action_trigger {
+						actions = [action.podman_container_restart.web]
+						events  = [after_create, after_update]
+					}
+				}
+			}
+		`, framework.Url(), action, trigger)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("0", `
+					operation = "stop"
+					wait_for  = "running"
+				`),
+				ExpectError: regexp.MustCompile("wait_for can not be set"),
+			},
+			{
+				Config: config("0", `
+					operation = "kill"
+					signal    = "HUP"
+				`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: config("1", `wait_for = "healthy"`),
+				Check: func(s *terraform.State) error {
+					c, err := apiServer.CaptureContainer("web")
+
+					if err != nil {
+						return err
+					}
+
+					if c.Restarts != 1 || !c.Running {
+						return fmt.Errorf("expected one restart, got %d", c.Restarts)
+					}
+
+					return nil
+				},
+			},
+			{
+				Config: config("2", `
+					operation = "kill"
+					signal    = "SIGHUP"
+				`),
+				Check: func(s *terraform.State) error {
+					c, err := apiServer.CaptureContainer("web")
+
+					if err != nil {
+						return err
+					}
+
+					if !slices.Equal(c.SignalLog, []string{"SIGHUP"}) || c.Restarts != 1 {
+						return fmt.Errorf("unexpected signals %v", c.SignalLog)
+					}
+
+					return nil
+				},
+			},
+			{
+				Config: config("3", `operation = "stop"`),
+				Check: func(s *terraform.State) error {
+					c, err := apiServer.CaptureContainer("web")
+
+					if err != nil {
+						return err
+					}
+
+					if c.Running {
+						return fmt.Errorf("container is still running")
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
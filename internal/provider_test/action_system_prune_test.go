@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"gotest.tools/v3/assert"
+)
+
+func TestAccSystemPruneAction(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Containers: []*testutil.TestContainer{
+			{
+				Id:   "stoppedid",
+				Json: api.ContainerCreateJson{Image: "oldid", Name: "stopped"},
+			},
+			{
+				Id: "runningid",
+				Json: api.ContainerCreateJson{
+					Image:    "usedid",
+					Name:     "running",
+					Networks: map[string]api.ContainerCreateNetworkJson{"backend": {}},
+				},
+				Running: true,
+			},
+		},
+		Images: []*api.ImageJson{
+			{Id: "oldid", Names: []string{"example.com/old:v1"}, Size: 5000000},
+			{Id: "usedid", Names: []string{"example.com/used:v1"}, Size: 7000000},
+			{Id: "danglingid", Size: 1000000},
+		},
+		Networks: []*api.NetworkJson{
+			{Id: "backendid", Name: "backend"},
+			{Id: "unusedid", Name: "unused"},
+		},
+		PruneErrors: map[string]string{
+			"danglingid": "image is in use by a build container",
+		},
+		Volumes: []*api.VolumeJson{
+			{Name: "data"},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(trigger string, action string) string {
+		return fmt.Sprintf(`
+			provider "podman" {
+				container_host = "%s"
+			}
+
+			action "podman_system_prune" "host" {
+				config {
+					%s
+				}
+			}
+
+			resource "terraform_data" "nightly" {
+				input = "%s"
+
+				lifecycle {
+					action_trigger {
+						actions = [action.podman_system_prune.host]
+						events  = [after_create, after_update]
+					}
+				}
+			}
+		`, framework.Url(), action, trigger)
+	}
+
+	// Counts the remaining containers, images, networks and volumes
+	expectRemaining := func(expected ...int) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			counts := make([]int, 4)
+
+			_ = apiServer.ContainerWalk(func(*testutil.TestContainer) error {
+				counts[0]++
+
+				return nil
+			})
+
+			_ = apiServer.ImageWalk(func(*api.ImageJson) error {
+				counts[1]++
+
+				return nil
+			})
+
+			_ = apiServer.NetworkWalk(func(*api.NetworkJson) error {
+				counts[2]++
+
+				return nil
+			})
+
+			_ = apiServer.VolumeWalk(func(*api.VolumeJson) error {
+				counts[3]++
+
+				return nil
+			})
+
+			if !slices.Equal(counts, expected) {
+				return fmt.Errorf("expected %v objects to remain, got %v", expected, counts)
+			}
+
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("1", `
+					dry_run = true
+					targets = ["containers", "images", "networks", "volumes"]
+				`),
+				Check: expectRemaining(2, 3, 2, 1),
+			},
+			{
+				// Images that fail to be removed are reported as warnings
+				Config: config("2", `all_images = true`),
+				Check:  expectRemaining(1, 2, 1, 1),
+			},
+		},
+	})
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// A stand-in for the kreuzwerker/docker provider, which is used to test `moved` blocks that
+// migrate Docker provider state into this provider. Each resource simply stores its
+// configuration, and only declares the subset of the real provider's attributes that the tests
+// need. Nested blocks in the real provider are declared as nested attributes here, which have
+// the same representation in raw state.
+
+type dockerStubProvider struct{}
+
+type dockerStubResource struct {
+	typeName   string
+	attributes map[string]schema.Attribute
+}
+
+func newDockerStubProvider() provider.Provider {
+	return &dockerStubProvider{}
+}
+
+func (p *dockerStubProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "docker"
+}
+
+func (p *dockerStubProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+}
+
+func (p *dockerStubProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+}
+
+func (p *dockerStubProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *dockerStubProvider) Resources(ctx context.Context) []func() resource.Resource {
+	labels := schema.SetNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"label": schema.StringAttribute{Required: true},
+				"value": schema.StringAttribute{Required: true},
+			},
+		},
+		Optional: true,
+	}
+
+	return []func() resource.Resource{
+		func() resource.Resource {
+			return &dockerStubResource{
+				typeName: "docker_container",
+				attributes: map[string]schema.Attribute{
+					"capabilities": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"add":  schema.SetAttribute{ElementType: types.StringType, Optional: true},
+								"drop": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+							},
+						},
+						Optional: true,
+					},
+					"command":    schema.ListAttribute{ElementType: types.StringType, Optional: true},
+					"cpu_set":    schema.StringAttribute{Optional: true},
+					"cpu_shares": schema.Int64Attribute{Optional: true},
+					"cpus":       schema.StringAttribute{Optional: true},
+					"dns":        schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dns_opts":   schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"dns_search": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"env":        schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"healthcheck": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"interval":     schema.StringAttribute{Optional: true},
+								"retries":      schema.Int64Attribute{Optional: true},
+								"start_period": schema.StringAttribute{Optional: true},
+								"test":         schema.ListAttribute{ElementType: types.StringType, Required: true},
+								"timeout":      schema.StringAttribute{Optional: true},
+							},
+						},
+						Optional: true,
+					},
+					"host": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"host": schema.StringAttribute{Required: true},
+								"ip":   schema.StringAttribute{Required: true},
+							},
+						},
+						Optional: true,
+					},
+					"hostname":   schema.StringAttribute{Optional: true},
+					"id":         schema.StringAttribute{Required: true},
+					"image":      schema.StringAttribute{Required: true},
+					"init":       schema.BoolAttribute{Optional: true},
+					"labels":     labels,
+					"log_driver": schema.StringAttribute{Optional: true},
+					"log_opts":   schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					"mounts": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"bind_options": schema.ListNestedAttribute{
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"propagation": schema.StringAttribute{Optional: true},
+										},
+									},
+									Optional: true,
+								},
+								"read_only": schema.BoolAttribute{Optional: true},
+								"source":    schema.StringAttribute{Optional: true},
+								"target":    schema.StringAttribute{Required: true},
+								"tmpfs_options": schema.ListNestedAttribute{
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"mode":       schema.Int64Attribute{Optional: true},
+											"size_bytes": schema.Int64Attribute{Optional: true},
+										},
+									},
+									Optional: true,
+								},
+								"type": schema.StringAttribute{Required: true},
+							},
+						},
+						Optional: true,
+					},
+					"memory":       schema.Int64Attribute{Optional: true},
+					"memory_swap":  schema.Int64Attribute{Optional: true},
+					"name":         schema.StringAttribute{Required: true},
+					"network_mode": schema.StringAttribute{Optional: true},
+					"networks_advanced": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"aliases": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+								"name":    schema.StringAttribute{Required: true},
+							},
+						},
+						Optional: true,
+					},
+					"ports": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"external": schema.Int64Attribute{Optional: true},
+								"internal": schema.Int64Attribute{Required: true},
+								"ip":       schema.StringAttribute{Optional: true},
+								"protocol": schema.StringAttribute{Optional: true},
+							},
+						},
+						Optional: true,
+					},
+					"privileged": schema.BoolAttribute{Optional: true},
+					"read_only":  schema.BoolAttribute{Optional: true},
+					"restart":    schema.StringAttribute{Optional: true},
+					"sysctls":    schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					"ulimit": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"hard": schema.Int64Attribute{Required: true},
+								"name": schema.StringAttribute{Required: true},
+								"soft": schema.Int64Attribute{Required: true},
+							},
+						},
+						Optional: true,
+					},
+					"user": schema.StringAttribute{Optional: true},
+					"volumes": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"container_path": schema.StringAttribute{Optional: true},
+								"from_container": schema.StringAttribute{Optional: true},
+								"read_only":      schema.BoolAttribute{Optional: true},
+							},
+						},
+						Optional: true,
+					},
+					"working_dir": schema.StringAttribute{Optional: true},
+				},
+			}
+		},
+		func() resource.Resource {
+			return &dockerStubResource{
+				typeName: "docker_image",
+				attributes: map[string]schema.Attribute{
+					"id":           schema.StringAttribute{Required: true},
+					"image_id":     schema.StringAttribute{Required: true},
+					"keep_locally": schema.BoolAttribute{Optional: true},
+					"name":         schema.StringAttribute{Required: true},
+				},
+			}
+		},
+		func() resource.Resource {
+			return &dockerStubResource{
+				typeName: "docker_network",
+				attributes: map[string]schema.Attribute{
+					"driver":   schema.StringAttribute{Optional: true},
+					"id":       schema.StringAttribute{Required: true},
+					"internal": schema.BoolAttribute{Optional: true},
+					"ipam_config": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"gateway": schema.StringAttribute{Optional: true},
+								"subnet":  schema.StringAttribute{Optional: true},
+							},
+						},
+						Optional: true,
+					},
+					"ipv6":   schema.BoolAttribute{Optional: true},
+					"labels": labels,
+					"name":   schema.StringAttribute{Required: true},
+				},
+			}
+		},
+	}
+}
+
+func (r *dockerStubResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.typeName
+}
+
+func (r *dockerStubResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{Attributes: r.attributes}
+}
+
+func (r *dockerStubResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *dockerStubResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *dockerStubResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *dockerStubResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
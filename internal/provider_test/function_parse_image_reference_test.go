@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestAccParseImageReferenceFunction(t *testing.T) {
+	digest := "sha256:6efd0df010dc3cb40d5e33e3ef84acecc5e73161bd3df06029ee8698e5e12c60"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "short" {
+						value = provider::podman::parse_image_reference("postgres")
+					}
+
+					output "qualified" {
+						value = provider::podman::parse_image_reference("localhost:5000/team/app:v1.2.3@` + digest + `")
+					}
+
+					output "digest_only" {
+						value = provider::podman::parse_image_reference("quay.io/podman/hello@` + digest + `")
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("short", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"digest":     knownvalue.Null(),
+						"name":       knownvalue.StringExact("docker.io/library/postgres"),
+						"normalized": knownvalue.StringExact("docker.io/library/postgres:latest"),
+						"registry":   knownvalue.StringExact("docker.io"),
+						"repository": knownvalue.StringExact("library/postgres"),
+						"tag":        knownvalue.StringExact("latest"),
+					})),
+					statecheck.ExpectKnownOutputValue("qualified", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"digest":     knownvalue.StringExact(digest),
+						"name":       knownvalue.StringExact("localhost:5000/team/app"),
+						"normalized": knownvalue.StringExact("localhost:5000/team/app:v1.2.3@" + digest),
+						"registry":   knownvalue.StringExact("localhost:5000"),
+						"repository": knownvalue.StringExact("team/app"),
+						"tag":        knownvalue.StringExact("v1.2.3"),
+					})),
+					statecheck.ExpectKnownOutputValue("digest_only", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"digest":     knownvalue.StringExact(digest),
+						"name":       knownvalue.StringExact("quay.io/podman/hello"),
+						"normalized": knownvalue.StringExact("quay.io/podman/hello@" + digest),
+						"registry":   knownvalue.StringExact("quay.io"),
+						"repository": knownvalue.StringExact("podman/hello"),
+						"tag":        knownvalue.Null(),
+					})),
+				},
+			},
+			{
+				Config: `
+					output "invalid" {
+						value = provider::podman::parse_image_reference("docker.io/Library/postgres")
+					}
+				`,
+				ExpectError: regexp.MustCompile("invalid repository name"),
+			},
+		},
+	})
+}
+
+func TestAccIsDigestPinnedFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "pinned" {
+						value = provider::podman::is_digest_pinned("postgres:17.5@sha256:6efd0df010dc3cb40d5e33e3ef84acecc5e73161bd3df06029ee8698e5e12c60")
+					}
+
+					output "unpinned" {
+						value = provider::podman::is_digest_pinned("postgres:17.5")
+					}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("pinned", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("unpinned", knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: `
+					output "invalid" {
+						value = provider::podman::is_digest_pinned("postgres@sha256:1234")
+					}
+				`,
+				ExpectError: regexp.MustCompile("invalid sha256 digest"),
+			},
+		},
+	})
+}
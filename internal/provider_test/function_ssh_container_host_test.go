@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestAccSshContainerHostFunction(t *testing.T) {
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEuFDZbl5ys7JJlRYPCSjAiPwprkNMS7Uzg2xYI0GWR3"
+	caPublicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOzMB/yLNO/Kd8qCrKpBp2Gd4MYb3ZdqK17wxbkDqJpO"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					output "pubkey" {
+						value = provider::podman::ssh_container_host("core", "example.com", null, "/run/podman/podman.sock", "%s host@example.com")
+					}
+
+					output "ca" {
+						value = provider::podman::ssh_container_host("core", "2001:db8::1", 2222, "/run/user/1000/podman/podman.sock", "cert-authority %s")
+					}
+				`, publicKey, caPublicKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"pubkey",
+						knownvalue.StringExact("ssh://core@example.com/run/podman/podman.sock#pubkey="+url.QueryEscape(publicKey)),
+					),
+					statecheck.ExpectKnownOutputValue(
+						"ca",
+						knownvalue.StringExact("ssh://core@[2001:db8::1]:2222/run/user/1000/podman/podman.sock#ca="+url.QueryEscape(caPublicKey)),
+					),
+				},
+			},
+			{
+				Config: `
+					output "invalid" {
+						value = provider::podman::ssh_container_host("core", "example.com", null, "/run/podman/podman.sock", "not a key")
+					}
+				`,
+				ExpectError: regexp.MustCompile("unable to parse host_key"),
+			},
+		},
+	})
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"gotest.tools/v3/assert"
+)
+
+func TestAccListResources(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Containers: []*testutil.TestContainer{
+			{
+				Id: "webid",
+				Json: api.ContainerCreateJson{
+					Image:    "docker.io/library/nginx:1.27",
+					Labels:   map[string]string{"app": "web"},
+					Name:     "web",
+					Networks: map[string]api.ContainerCreateNetworkJson{"backendid": {}},
+				},
+			},
+			{
+				Id:   "dbid",
+				Json: api.ContainerCreateJson{Image: "imageid", Name: "db"},
+			},
+		},
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"docker.io/library/nginx:1.27"},
+			},
+			{
+				Id: "untaggedid",
+			},
+		},
+		Networks: []*api.NetworkJson{
+			{
+				DnsEnabled: true,
+				Id:         "frontendid",
+				Labels:     map[string]string{"tier": "frontend"},
+				Name:       "frontend",
+			},
+			{
+				Id:   "backendid",
+				Name: "backend",
+			},
+		},
+		Secrets: []*api.SecretInspectJson{
+			{
+				Id:   "secretid",
+				Spec: api.SecretInspectSpecJson{Name: "web-password"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					provider "podman" {
+						container_host = "%s"
+					}
+				`, framework.Url()),
+			},
+			{
+				Query: true,
+				Config: `
+					list "podman_container" "web" {
+						provider         = podman
+						include_resource = true
+
+						config {
+							labels = { app = "web" }
+						}
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("podman_container.web", 1),
+					querycheck.ExpectIdentity("podman_container.web", map[string]knownvalue.Check{
+						"container_host": knownvalue.Null(),
+						"id":             knownvalue.StringExact("webid"),
+					}),
+					querycheck.ExpectResourceKnownValues(
+						"podman_container.web",
+						queryfilter.ByDisplayName(knownvalue.StringExact("web")),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("image"),
+								KnownValue: knownvalue.StringExact("imageid"),
+							},
+							{
+								Path:       tfjsonpath.New("labels").AtMapKey("app"),
+								KnownValue: knownvalue.StringExact("web"),
+							},
+						},
+					),
+				},
+			},
+			{
+				Query: true,
+				Config: `
+					list "podman_image" "all" {
+						provider         = podman
+						include_resource = true
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("podman_image.all", 2),
+					querycheck.ExpectResourceDisplayName(
+						"podman_image.all",
+						queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+							"container_host": knownvalue.Null(),
+							"id":             knownvalue.StringExact("imageid"),
+						}),
+						knownvalue.StringExact("docker.io/library/nginx:1.27"),
+					),
+					querycheck.ExpectResourceKnownValues(
+						"podman_image.all",
+						queryfilter.ByDisplayName(knownvalue.StringExact("untaggedid")),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("reference"),
+								KnownValue: knownvalue.Null(),
+							},
+						},
+					),
+				},
+			},
+			{
+				Query: true,
+				Config: `
+					list "podman_network" "back" {
+						provider = podman
+
+						config {
+							name = "^back"
+						}
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("podman_network.back", 1),
+					querycheck.ExpectIdentity("podman_network.back", map[string]knownvalue.Check{
+						"container_host": knownvalue.Null(),
+						"id":             knownvalue.StringExact("backendid"),
+					}),
+				},
+			},
+			{
+				Query: true,
+				Config: `
+					list "podman_network_attachment" "all" {
+						provider         = podman
+						include_resource = true
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("podman_network_attachment.all", 1),
+					querycheck.ExpectIdentity("podman_network_attachment.all", map[string]knownvalue.Check{
+						"container_host": knownvalue.Null(),
+						"id":             knownvalue.StringExact("web/backend"),
+					}),
+					querycheck.ExpectResourceKnownValues(
+						"podman_network_attachment.all",
+						queryfilter.ByDisplayName(knownvalue.StringExact("web/backend")),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("container"),
+								KnownValue: knownvalue.StringExact("web"),
+							},
+							{
+								Path:       tfjsonpath.New("network"),
+								KnownValue: knownvalue.StringExact("backend"),
+							},
+						},
+					),
+				},
+			},
+			{
+				Query: true,
+				Config: `
+					list "podman_secret" "all" {
+						provider         = podman
+						include_resource = true
+					}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("podman_secret.all", 1),
+					querycheck.ExpectResourceKnownValues(
+						"podman_secret.all",
+						queryfilter.ByDisplayName(knownvalue.StringExact("web-password")),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("id"),
+								KnownValue: knownvalue.StringExact("secretid"),
+							},
+							{
+								Path:       tfjsonpath.New("value_version"),
+								KnownValue: knownvalue.NumberExact(big.NewFloat(1)),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
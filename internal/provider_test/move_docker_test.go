@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"gotest.tools/v3/assert"
+)
+
+func TestAccMoveFromDockerProvider(t *testing.T) {
+	initProcess := true
+	apiServer := testutil.ApiServer{
+		Containers: []*testutil.TestContainer{
+			{
+				Id: "containerid",
+				Json: api.ContainerCreateJson{
+					DnsOption: []string{"ndots:2"},
+					DnsSearch: []string{"example.com"},
+					DnsServer: []string{"10.0.0.2"},
+					HostAdd:   []string{"db.internal:10.0.0.5"},
+					Hostname:  "www",
+					Init:      &initProcess,
+					LogConfiguration: &api.ContainerCreateLogConfigJson{
+						Driver:  "k8s-file",
+						Options: map[string]string{"tag": "web"},
+					},
+					Name:    "web",
+					WorkDir: "/srv",
+				},
+				Running: true,
+			},
+		},
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"docker.io/library/nginx:1.27"},
+			},
+		},
+		Networks: []*api.NetworkJson{
+			{
+				DnsEnabled: true,
+				Id:         "networkid",
+				Name:       "backend",
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"docker": providerserver.NewProtocol6WithError(newDockerStubProvider()),
+	}
+
+	for name, factory := range providerFactories {
+		factories[name] = factory
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: factories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "docker_image" "nginx" {
+						id           = "sha256:imageiddocker.io/library/nginx:1.27"
+						image_id     = "sha256:imageid"
+						keep_locally = true
+						name         = "docker.io/library/nginx:1.27"
+					}
+
+					resource "docker_network" "backend" {
+						driver = "bridge"
+						id     = "networkid"
+						name   = "backend"
+
+						ipam_config = [{
+							gateway = "10.89.0.1"
+							subnet  = "10.89.0.0/24"
+						}]
+					}
+
+					resource "docker_container" "web" {
+						cpu_shares  = 512
+						cpus        = "1.5"
+						dns         = ["10.0.0.2"]
+						dns_opts    = ["ndots:2"]
+						dns_search  = ["example.com"]
+						env         = ["A=1", "B=x=y"]
+						hostname    = "www"
+						id          = "containerid"
+						image       = docker_image.nginx.image_id
+						init        = true
+						log_driver  = "json-file"
+						log_opts    = { max-size = "10m", tag = "web" }
+						memory      = 256
+						memory_swap = 512
+						name        = "web"
+
+						healthcheck = [{
+							interval = "30s"
+							retries  = 3
+							test     = ["CMD-SHELL", "curl -f http://localhost/"]
+							timeout  = "0s"
+						}]
+
+						host = [{
+							host = "db.internal"
+							ip   = "10.0.0.5"
+						}]
+
+						labels = [{
+							label = "app"
+							value = "web"
+						}]
+
+						mounts = [
+							{
+								bind_options = [{ propagation = "rslave" }]
+								read_only    = true
+								source       = "/srv/www"
+								target       = "/usr/share/nginx/html"
+								type         = "bind"
+							},
+							{
+								target = "/var/cache/nginx"
+								type   = "tmpfs"
+
+								tmpfs_options = [{
+									mode       = 448
+									size_bytes = 67108864
+								}]
+							},
+						]
+
+						networks_advanced = [{
+							aliases = ["www"]
+							name    = docker_network.backend.name
+						}]
+
+						ports = [
+							{
+								external = 8080
+								internal = 80
+								ip       = "0.0.0.0"
+								protocol = "tcp"
+							},
+							{
+								external = 5353
+								internal = 53
+								ip       = "127.0.0.1"
+								protocol = "udp"
+							},
+						]
+
+						capabilities = [{
+							add  = ["CAP_NET_ADMIN"]
+							drop = ["CAP_MKNOD"]
+						}]
+
+						privileged = true
+						read_only  = true
+						restart    = "unless-stopped"
+						sysctls    = { "net.core.somaxconn" = "1024" }
+
+						ulimit = [{
+							hard = 65536
+							name = "nofile"
+							soft = 4096
+						}]
+
+						user = "101:102"
+
+						volumes = [{
+							from_container = "assets"
+							read_only      = true
+						}]
+
+						working_dir = "/srv"
+					}
+				`,
+			},
+			{
+				Config: fmt.Sprintf(`
+					provider "podman" {
+						container_host = "%s"
+					}
+
+					moved {
+						from = docker_image.nginx
+						to   = podman_image.nginx
+					}
+
+					moved {
+						from = docker_network.backend
+						to   = podman_network.backend
+					}
+
+					moved {
+						from = docker_container.web
+						to   = podman_container.web
+					}
+
+					resource "podman_image" "nginx" {
+						preserve  = true
+						reference = "docker.io/library/nginx:1.27"
+					}
+
+					resource "podman_network" "backend" {
+						dns_enabled = true
+						name        = "backend"
+					}
+
+					resource "podman_container" "web" {
+						dns_options = ["ndots:2"]
+						dns_search  = ["example.com"]
+						dns_servers = ["10.0.0.2"]
+
+						env = {
+							A = "1"
+							B = "x=y"
+						}
+
+						extra_hosts = [{
+							hostname = "db.internal"
+							ip       = "10.0.0.5"
+						}]
+
+						health = {
+							check = {
+								shell_command = "curl -f http://localhost/"
+							}
+
+							interval = 30
+							retries  = 3
+						}
+
+						hostname   = "www"
+						image      = podman_image.nginx.id
+						init       = true
+						labels     = { app = "web" }
+						log_driver = "k8s-file"
+
+						log_options = {
+							max_size = 10485760
+							tag      = "web"
+						}
+
+						mounts = [
+							{
+								propagation = "rslave"
+								read_only   = true
+								source      = "/srv/www"
+								target      = "/usr/share/nginx/html"
+							},
+							{
+								mode   = 448
+								size   = 67108864
+								target = "/var/cache/nginx"
+								type   = "tmpfs"
+							},
+						]
+
+						name = "web"
+
+						networks = [{
+							aliases = ["www"]
+							id      = podman_network.backend.name
+						}]
+
+						port_mappings = [
+							{
+								container_port = 80
+								host_port      = 8080
+							},
+							{
+								container_port = 53
+								host_ip        = "127.0.0.1"
+								host_port      = 5353
+								protocols      = ["udp"]
+							},
+						]
+
+						resources = {
+							cpu_shares  = 512
+							cpus        = 1.5
+							memory      = 268435456
+							memory_swap = 536870912
+						}
+
+						restart_policy = "unless-stopped"
+
+						security = {
+							cap_add          = ["CAP_NET_ADMIN"]
+							cap_drop         = ["CAP_MKNOD"]
+							privileged       = true
+							read_only_rootfs = true
+						}
+
+						sysctls = { "net.core.somaxconn" = "1024" }
+
+						ulimits = [{
+							hard = 65536
+							name = "nofile"
+							soft = 4096
+						}]
+
+						user = {
+							group = "102"
+							user  = "101"
+						}
+
+						volumes_from = ["assets:ro"]
+						working_dir  = "/srv"
+					}
+				`, framework.Url()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						// Every translated attribute must match the configuration
+						plancheck.ExpectResourceAction("podman_container.web", plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"podman_image.nginx",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("imageid"),
+					),
+					statecheck.ExpectKnownValue(
+						"podman_network.backend",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("networkid"),
+					),
+					statecheck.ExpectKnownValue(
+						"podman_container.web",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("containerid"),
+					),
+					statecheck.ExpectKnownValue(
+						"podman_container.web",
+						tfjsonpath.New("image"),
+						knownvalue.StringExact("imageid"),
+					),
+					statecheck.ExpectKnownValue(
+						"podman_container.web",
+						tfjsonpath.New("health").AtMapKey("interval"),
+						knownvalue.NumberExact(big.NewFloat(30)),
+					),
+					statecheck.ExpectKnownValue(
+						"podman_container.web",
+						tfjsonpath.New("network_namespace").AtMapKey("mode"),
+						knownvalue.StringExact("bridge"),
+					),
+					statecheck.ExpectKnownValue(
+						"podman_container.web",
+						tfjsonpath.New("resources").AtMapKey("memory"),
+						knownvalue.Int64Exact(268435456),
+					),
+				},
+			},
+		},
+	})
+}
@@ -3,19 +3,42 @@ package provider
 import (
 	"encoding/base64"
 	"fmt"
+	"math"
 	"regexp"
 	"testing"
 
 	"github.com/decafcode/terraform-provider-podman/internal/api"
 	"github.com/decafcode/terraform-provider-podman/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/assert/cmp"
 )
 
 func TestAccContainerResource(t *testing.T) {
-	apiServer := testutil.ApiServer{}
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+		Networks: []*api.NetworkJson{
+			{
+				Id:   "networkid",
+				Name: "mynetwork",
+			},
+			{
+				Id:   "staticid",
+				Name: "static",
+			},
+		},
+	}
 	framework, err := spawnFramework(t.Context(), &apiServer)
 	assert.NilError(t, err)
 
@@ -79,6 +102,14 @@ func TestAccContainerResource(t *testing.T) {
 						networks = [
 							{
 								id = "networkid"
+							},
+							{
+								aliases        = ["db", "primary"]
+								id             = "staticid"
+								interface_name = "eth9"
+								ipv4_address   = "10.89.0.10"
+								ipv6_address   = "fd00::10"
+								mac_address    = "92:d0:c6:0a:29:33"
 							}
 						]
 
@@ -164,6 +195,12 @@ func TestAccContainerResource(t *testing.T) {
 							},
 							Networks: map[string]api.ContainerCreateNetworkJson{
 								"networkid": {},
+								"staticid": {
+									Aliases:       []string{"db", "primary"},
+									InterfaceName: "eth9",
+									StaticIPs:     []string{"10.89.0.10", "fd00::10"},
+									StaticMAC:     "92:d0:c6:0a:29:33",
+								},
 							},
 							PortMappings: []api.ContainerCreatePortMappingJson{
 								{
@@ -261,6 +298,46 @@ func TestAccContainerResource(t *testing.T) {
 					return nil
 				},
 			},
+			{
+				// Test auto-update labels
+				Config: fmt.Sprintf(`
+					resource "podman_container" "test" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "test"
+
+						auto_update = {
+							authfile = "/etc/containers/auth.json"
+							policy   = "registry"
+						}
+
+						labels = {
+							"org.example.tier" = "web"
+						}
+					}
+				`, framework.Url()),
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("test")
+
+					if err != nil {
+						return err
+					}
+
+					result := cmp.DeepEqual(capture.Json.Labels, map[string]string{
+						"io.containers.autoupdate":          "registry",
+						"io.containers.autoupdate.authfile": "/etc/containers/auth.json",
+						"org.example.tier":                  "web",
+					})()
+
+					if !result.Success() {
+						t.Log(result)
+
+						return fmt.Errorf("incorrect labels")
+					}
+
+					return nil
+				},
+			},
 			{
 				// Test create-and-upload, continued in the next step
 				Config: fmt.Sprintf(`
@@ -481,6 +558,50 @@ func TestAccContainerResource(t *testing.T) {
 					return nil
 				},
 			},
+			{
+				// Import by name using an identity, which should resolve to the container's ID
+				Config: fmt.Sprintf(`
+					import {
+						to = podman_container.import_test
+
+						identity = {
+							container_host = "%s"
+							id             = "health_shell_cmd"
+						}
+					}
+
+					resource "podman_container" "health_shell_cmd" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "health_shell_cmd"
+
+						health = {
+							check = {
+								shell_command = "x y"
+							}
+						}
+					}
+
+					resource "podman_container" "import_test" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "health_shell_cmd"
+					}
+				`, framework.Url(), framework.Url(), framework.Url()),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"podman_container.import_test",
+						tfjsonpath.New("id"),
+						"podman_container.health_shell_cmd",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+					statecheck.ExpectIdentityValueMatchesState(
+						"podman_container.import_test",
+						tfjsonpath.New("id"),
+					),
+				},
+			},
 			{
 				// Test negative duration check
 				Config: fmt.Sprintf(`
@@ -495,6 +616,2166 @@ func TestAccContainerResource(t *testing.T) {
 				`, framework.Url()),
 				ExpectError: regexp.MustCompile("Negative duration"),
 			},
+			{
+				// Test auto-update label conflict check
+				Config: fmt.Sprintf(`
+					resource "podman_container" "label_conflict" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+
+						auto_update = {
+							policy = "local"
+						}
+
+						labels = {
+							"io.containers.autoupdate" = "registry"
+						}
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("Conflicting labels"),
+			},
+		},
+	})
+}
+
+func TestAccContainerResourcePlanChecks(t *testing.T) {
+	other := &testutil.TestContainer{
+		Id: "other",
+		Json: api.ContainerCreateJson{
+			Name: "other",
+			PortMappings: []api.ContainerCreatePortMappingJson{
+				{
+					ContainerPort: 80,
+					HostPort:      8080,
+				},
+			},
+		},
+		Running: true,
+	}
+
+	apiServer := testutil.ApiServer{
+		Containers: []*testutil.TestContainer{other},
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			return apiServer.ContainerWalk(func(c *testutil.TestContainer) error {
+				if c.Id != other.Id {
+					return fmt.Errorf("leftover container: %s", c.Json.Name)
+				}
+
+				return nil
+			})
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "typo" {
+						container_host = "%s"
+						image          = "example.com/library/tset:v1.0.0"
+						name           = "typo"
+					}
+				`, framework.Url()),
+				// Only a warning at plan time, so the error comes from the container host
+				ExpectError: regexp.MustCompile("Container create failed"),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "typo" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "typo"
+
+						networks = [
+							{
+								id = "nosuchnetwork"
+							}
+						]
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("Container create failed"),
+			},
+			{
+				// A network may be referred to by name although it is only created by this plan
+				Config: fmt.Sprintf(`
+					resource "podman_network" "backend" {
+						container_host = "%[1]s"
+						name           = "backend"
+					}
+
+					resource "podman_container" "app" {
+						container_host = "%[1]s"
+						depends_on     = [podman_network.backend]
+						image          = "example.com/library/test:v1.0.0"
+						name           = "app"
+
+						networks = [
+							{
+								id = "backend"
+							}
+						]
+					}
+				`, framework.Url()),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "clash" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "clash"
+
+						port_mappings = [
+							{
+								container_port = 80
+								host_port      = 8080
+							}
+						]
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("Host port already in use"),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "clash" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "clash"
+
+						port_mappings = [
+							{
+								container_port = 80
+								host_port      = 8075
+								range          = 10
+							}
+						]
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("Host port already in use"),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "web" {
+						command        = ["serve"]
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "web"
+
+						port_mappings = [
+							{
+								container_port = 80
+								host_port      = 9090
+							}
+						]
+					}
+				`, framework.Url()),
+			},
+			{
+				// The replacement is planned without a prior state, but the container that it
+				// replaces must not be reported as holding its host port
+				Config: fmt.Sprintf(`
+					resource "podman_container" "web" {
+						command        = ["serve", "--verbose"]
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "web"
+
+						port_mappings = [
+							{
+								container_port = 80
+								host_port      = 9090
+							}
+						]
+					}
+				`, framework.Url()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("podman_container.web", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+			{
+				// Plan checks can be disabled for offline planning
+				Config: fmt.Sprintf(`
+					provider "podman" {
+						skip_plan_checks = true
+					}
+
+					resource "podman_container" "typo" {
+						container_host = "%s"
+						image          = "example.com/library/tset:v1.0.0"
+						name           = "typo"
+					}
+				`, framework.Url()),
+				ExpectNonEmptyPlan: true,
+				PlanOnly:           true,
+			},
+		},
+	})
+}
+
+func TestAccContainerResourceLimits(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(resources string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "limited" {
+				container_host = "%s"
+				image          = "example.com/library/test:v1.0.0"
+				name           = "limited"
+
+				resources = {
+					%s
+				}
+			}
+		`, framework.Url(), resources)
+	}
+
+	// Checks the container's limits, and whether it is the same container as in the previous step
+	var lastId string
+
+	expectLimits := func(replaced bool, updates int, limits *api.ContainerCreateResourceLimitsJson) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			capture, err := apiServer.CaptureContainer("limited")
+
+			if err != nil {
+				return err
+			}
+
+			if lastId != "" && (capture.Id != lastId) != replaced {
+				return fmt.Errorf("expected replaced=%v, container id went from %s to %s", replaced, lastId, capture.Id)
+			}
+
+			lastId = capture.Id
+
+			if capture.Updates != updates {
+				return fmt.Errorf("expected %d updates, got %d", updates, capture.Updates)
+			}
+
+			result := cmp.DeepEqual(capture.Json.ResourceLimits, limits)()
+
+			if !result.Success() {
+				t.Log(result)
+
+				return fmt.Errorf("incorrect resource limits")
+			}
+
+			return nil
+		}
+	}
+
+	ptr := func(v int64) *int64 { return &v }
+	period := uint64(100000)
+	weight := uint16(500)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+					cpus       = 1.5
+					memory     = 536870912
+					pids_limit = 100
+					shm_size   = 67108864
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					expectLimits(false, 0, &api.ContainerCreateResourceLimitsJson{
+						CPU:    &api.ContainerCreateCPUJson{Period: &period, Quota: ptr(150000)},
+						Memory: &api.ContainerCreateMemoryJson{Limit: ptr(536870912)},
+						Pids:   &api.ContainerCreatePidsJson{Limit: 100},
+					}),
+					func(_ *terraform.State) error {
+						capture, err := apiServer.CaptureContainer("limited")
+
+						if err != nil {
+							return err
+						}
+
+						if capture.Json.ShmSize == nil || *capture.Json.ShmSize != 67108864 {
+							return fmt.Errorf("incorrect shm_size")
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				// Changing and adding limits updates the container in place
+				Config: config(`
+					blkio_weight = 500
+					cpus         = 1.5
+					memory       = 1073741824
+					pids_limit   = 100
+					shm_size     = 67108864
+				`),
+				Check: expectLimits(false, 1, &api.ContainerCreateResourceLimitsJson{
+					BlockIO: &api.ContainerCreateBlockIOJson{Weight: &weight},
+					CPU:     &api.ContainerCreateCPUJson{Period: &period, Quota: ptr(150000)},
+					Memory:  &api.ContainerCreateMemoryJson{Limit: ptr(1073741824)},
+					Pids:    &api.ContainerCreatePidsJson{Limit: 100},
+				}),
+			},
+			{
+				// Removing a limit replaces the container
+				Config: config(`
+					blkio_weight = 500
+					memory       = 1073741824
+					pids_limit   = 100
+					shm_size     = 67108864
+				`),
+				Check: expectLimits(true, 0, &api.ContainerCreateResourceLimitsJson{
+					BlockIO: &api.ContainerCreateBlockIOJson{Weight: &weight},
+					Memory:  &api.ContainerCreateMemoryJson{Limit: ptr(1073741824)},
+					Pids:    &api.ContainerCreatePidsJson{Limit: 100},
+				}),
+			},
+			{
+				// So does changing shm_size
+				Config: config(`
+					blkio_weight = 500
+					memory       = 1073741824
+					pids_limit   = 100
+					shm_size     = 134217728
+				`),
+				Check: expectLimits(true, 0, &api.ContainerCreateResourceLimitsJson{
+					BlockIO: &api.ContainerCreateBlockIOJson{Weight: &weight},
+					Memory:  &api.ContainerCreateMemoryJson{Limit: ptr(1073741824)},
+					Pids:    &api.ContainerCreatePidsJson{Limit: 100},
+				}),
+			},
+		},
+	})
+}
+
+func TestAccContainerRestartPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		apiVersion string
+		replaced   bool
+	}{
+		{apiVersion: "5.5.2", replaced: false},
+		{apiVersion: "4.9.3", replaced: true},
+		{apiVersion: "unknown", replaced: false},
+	} {
+		t.Run(tc.apiVersion, func(t *testing.T) {
+			apiServer := testutil.ApiServer{
+				ApiVersion: tc.apiVersion,
+				Images: []*api.ImageJson{
+					{
+						Id:    "imageid",
+						Names: []string{"example.com/library/test:v1.0.0"},
+					},
+				},
+			}
+
+			framework, err := spawnFramework(t.Context(), &apiServer)
+			assert.NilError(t, err)
+
+			defer framework.Stop(t.Context())
+
+			config := func(restart string) string {
+				return fmt.Sprintf(`
+					resource "podman_container" "db" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "db"
+						%s
+					}
+				`, framework.Url(), restart)
+			}
+
+			var lastId string
+
+			expectPolicy := func(replaced bool, policy string, retries *uint) resource.TestCheckFunc {
+				return func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("db")
+
+					if err != nil {
+						return err
+					}
+
+					if lastId != "" && (capture.Id != lastId) != replaced {
+						return fmt.Errorf("expected replaced=%v, container id went from %s to %s", replaced, lastId, capture.Id)
+					}
+
+					lastId = capture.Id
+
+					if capture.Json.RestartPolicy != policy {
+						return fmt.Errorf("expected restart policy %q, got %q", policy, capture.Json.RestartPolicy)
+					}
+
+					result := cmp.DeepEqual(capture.Json.RestartTries, retries)()
+
+					if !result.Success() {
+						t.Log(result)
+
+						return fmt.Errorf("incorrect restart retries")
+					}
+
+					return nil
+				}
+			}
+
+			retries := uint(3)
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: providerFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config(`
+							restart_policy  = "always"
+							restart_retries = 3
+						`),
+						ExpectError: regexp.MustCompile("Invalid attribute combination"),
+					},
+					{
+						Config: config(""),
+						Check:  expectPolicy(false, "", nil),
+					},
+					{
+						Config: config(`restart_policy = "always"`),
+						Check:  expectPolicy(tc.replaced, "always", nil),
+					},
+					{
+						Config: config(`
+							restart_policy  = "on-failure"
+							restart_retries = 3
+						`),
+						Check: expectPolicy(tc.replaced, "on-failure", &retries),
+					},
+					{
+						// Skipping plan checks must not turn a replacement into an update
+						Config: `
+							provider "podman" {
+								skip_plan_checks = true
+							}
+						` + config(`restart_policy = "unless-stopped"`),
+						Check: expectPolicy(tc.replaced, "unless-stopped", nil),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccContainerDesiredState(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(desiredState string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "service" {
+				container_host = "%s"
+				desired_state  = "%s"
+				image          = "example.com/library/test:v1.0.0"
+				name           = "service"
+			}
+		`, framework.Url(), desiredState)
+	}
+
+	var lastId string
+
+	expectState := func(running bool, paused bool) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			capture, err := apiServer.CaptureContainer("service")
+
+			if err != nil {
+				return err
+			}
+
+			if lastId != "" && capture.Id != lastId {
+				return fmt.Errorf("container was replaced")
+			}
+
+			lastId = capture.Id
+
+			if capture.Running != running || capture.Paused != paused {
+				return fmt.Errorf("expected running=%v paused=%v, got running=%v paused=%v", running, paused, capture.Running, capture.Paused)
+			}
+
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "conflict" {
+						container_host    = "%s"
+						desired_state     = "running"
+						image             = "example.com/library/test:v1.0.0"
+						start_immediately = false
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: config("running"),
+				Check:  expectState(true, false),
+			},
+			{
+				// Simulate a crash, which the next apply should repair in place
+				PreConfig: func() {
+					_ = apiServer.ContainerWalk(func(c *testutil.TestContainer) error {
+						c.Exited = true
+						c.Running = false
+
+						return nil
+					})
+				},
+				Config: config("running"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("podman_container.service", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: expectState(true, false),
+			},
+			{
+				Config: config("paused"),
+				Check:  expectState(true, true),
+			},
+			{
+				Config: config("running"),
+				Check:  expectState(true, false),
+			},
+			{
+				Config: config("stopped"),
+				Check:  expectState(false, false),
+			},
+			{
+				Config: config("paused"),
+				Check:  expectState(true, true),
+			},
+			{
+				Config: config("created"),
+				Check:  expectState(false, false),
+			},
+		},
+	})
+}
+
+func TestAccContainerWaitFor(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+		OnContainerCreate: func(c *testutil.TestContainer) {
+			switch c.Json.Name {
+			case "broken":
+				c.Health = "unhealthy"
+				c.HealthLog = []api.ContainerInspectHealthLogJson{{ExitCode: 1, Output: "connection refused"}}
+				c.Logs = []string{"starting", "FATAL: missing configuration"}
+			case "crashed":
+				exitCode := 1
+				c.ExitCode = &exitCode
+				c.Logs = []string{"starting", "FATAL: address already in use"}
+			case "healthy":
+				c.Health = "healthy"
+			case "logged":
+				c.Logs = []string{"starting", "database system is ready to accept connections"}
+			}
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "stopped" {
+						container_host = "%s"
+						desired_state  = "stopped"
+						image          = "example.com/library/test:v1.0.0"
+
+						wait_for = {
+							state = "running"
+						}
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("Invalid attribute combination"),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "broken" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "broken"
+
+						wait_for = {
+							state   = "healthy"
+							timeout = 1
+						}
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile(`(?s)exit code 1: connection refused.*FATAL: missing configuration`),
+			},
+			{
+				// A container that exits is reported straight away rather than after the timeout
+				Config: fmt.Sprintf(`
+					resource "podman_container" "crashed" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "crashed"
+
+						wait_for = {
+							state   = "running"
+							timeout = 600
+						}
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile(`(?s)exited before it became ready.*FATAL: address already in use`),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "healthy" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "healthy"
+
+						wait_for = {
+							state = "healthy"
+						}
+					}
+
+					resource "podman_container" "logged" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "logged"
+
+						wait_for = {
+							log_pattern = "ready to accept connections$"
+						}
+					}
+				`, framework.Url(), framework.Url()),
+			},
+		},
+	})
+}
+
+func TestAccContainerJob(t *testing.T) {
+	exitCodes := map[string]int{"failing": 2, "migrate": 0}
+
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+		OnContainerCreate: func(c *testutil.TestContainer) {
+			exitCode, ok := exitCodes[c.Json.Name]
+
+			if ok {
+				c.ExitCode = &exitCode
+				c.Logs = []string{"applied 3 migrations"}
+				c.ErrorLogs = []string{"warning: slow query"}
+			}
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(name string, schema string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "%s" {
+				container_host = "%s"
+				image          = "example.com/library/test:v1.0.0"
+				name           = "%s"
+
+				job = {
+					capture_output = true
+
+					triggers = {
+						schema = "%s"
+					}
+				}
+			}
+		`, name, framework.Url(), name, schema)
+	}
+
+	var lastId string
+
+	expectSameContainer := func(_ *terraform.State) error {
+		capture, err := apiServer.CaptureContainer("migrate")
+
+		if err != nil {
+			return err
+		}
+
+		if lastId != "" && capture.Id != lastId {
+			return fmt.Errorf("container was replaced")
+		}
+
+		lastId = capture.Id
+
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "conflict" {
+						container_host = "%s"
+						desired_state  = "running"
+						image          = "example.com/library/test:v1.0.0"
+						job            = {}
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config:      config("failing", "1"),
+				ExpectError: regexp.MustCompile("(?s)exited with code 2.*applied 3 migrations"),
+			},
+			{
+				// The slow job never exits by itself
+				Config: fmt.Sprintf(`
+					resource "podman_container" "slow" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "slow"
+						stop_timeout   = 5
+
+						job = {
+							timeout = 1
+						}
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("Error waiting for job to finish"),
+			},
+			{
+				// A job that times out is stopped rather than left running
+				PreConfig: func() {
+					capture, err := apiServer.CaptureContainer("slow")
+					assert.NilError(t, err)
+					assert.Equal(t, capture.Running, false)
+					assert.DeepEqual(t, capture.StopLog, []string{"5"})
+				},
+				Config: config("migrate", "1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("podman_container.migrate", tfjsonpath.New("exit_code"), knownvalue.Int64Exact(0)),
+					statecheck.ExpectKnownValue("podman_container.migrate", tfjsonpath.New("stdout"), knownvalue.StringExact("applied 3 migrations\n")),
+					statecheck.ExpectKnownValue("podman_container.migrate", tfjsonpath.New("stderr"), knownvalue.StringExact("warning: slow query\n")),
+				},
+				Check: expectSameContainer,
+			},
+			{
+				// Changing the triggers runs the job again in the same container
+				PreConfig: func() {
+					_ = apiServer.ContainerWalk(func(c *testutil.TestContainer) error {
+						c.Logs = append(c.Logs, "applied 1 migration")
+
+						return nil
+					})
+				},
+				Config: config("migrate", "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("podman_container.migrate", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("podman_container.migrate", tfjsonpath.New("exit_code")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("podman_container.migrate", tfjsonpath.New("stdout"), knownvalue.StringExact("applied 3 migrations\napplied 1 migration\n")),
+				},
+				Check: expectSameContainer,
+			},
+		},
+	})
+}
+
+func TestAccContainerStop(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(extra string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "db" {
+				container_host = "%s"
+				image          = "example.com/library/test:v1.0.0"
+				name           = "db"
+				%s
+			}
+		`, framework.Url(), extra)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if len(apiServer.Removed) != 1 {
+				return fmt.Errorf("expected one container to be removed, got %d", len(apiServer.Removed))
+			}
+
+			removed := apiServer.Removed[0]
+
+			if !removed.Volumes {
+				return fmt.Errorf("expected volumes to be removed with the container")
+			}
+
+			result := cmp.DeepEqual(removed.Container.StopLog, []string{"30"})()
+
+			if !result.Success() {
+				t.Log(result)
+
+				return fmt.Errorf("incorrect stop timeout on destroy")
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`stop_signal = "SIGBOGUS"`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: config(`
+					stop_signal  = "SIGINT"
+					stop_timeout = 30
+				`),
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("db")
+
+					if err != nil {
+						return err
+					}
+
+					signal := 2
+					timeout := uint(30)
+					result := cmp.DeepEqual(
+						[]any{capture.Json.StopSignal, capture.Json.StopTimeout},
+						[]any{&signal, &timeout},
+					)()
+
+					if !result.Success() {
+						t.Log(result)
+
+						return fmt.Errorf("incorrect stop configuration")
+					}
+
+					return nil
+				},
+			},
+			{
+				// Enabling volume removal must not replace the container
+				Config: config(`
+					remove_volumes = true
+					stop_signal    = "SIGINT"
+					stop_timeout   = 30
+				`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("podman_container.db", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccContainerBlueGreen(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Containers: []*testutil.TestContainer{
+			{
+				Id:      "unrelatedid",
+				Json:    api.ContainerCreateJson{Name: "api"},
+				Running: true,
+			},
+		},
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+		OnContainerCreate: func(c *testutil.TestContainer) {
+			if c.Json.Env["VERSION"] == "broken" {
+				c.Health = "unhealthy"
+			} else {
+				c.Health = "healthy"
+			}
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	configNamed := func(name string, version string, waitFor string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "web" {
+				blue_green     = true
+				container_host = "%s"
+				env            = { VERSION = "%s" }
+				image          = "example.com/library/test:v1.0.0"
+				name           = "%s"
+
+				%s
+
+				lifecycle {
+					create_before_destroy = true
+				}
+			}
+		`, framework.Url(), version, name, waitFor)
+	}
+
+	config := func(version string, waitFor string) string {
+		return configNamed("web", version, waitFor)
+	}
+
+	waitFor := `
+		wait_for = {
+			state   = "healthy"
+			timeout = 1
+		}
+	`
+
+	var lastId string
+
+	expectVersion := func(version string, replaced bool) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			var names []string
+			err := apiServer.ContainerWalk(func(c *testutil.TestContainer) error {
+				if c.Id != "unrelatedid" {
+					names = append(names, c.Json.Name)
+				}
+
+				return nil
+			})
+
+			if err != nil {
+				return err
+			}
+
+			result := cmp.DeepEqual(names, []string{"web"})()
+
+			if !result.Success() {
+				t.Log(result)
+
+				return fmt.Errorf("expected a single container")
+			}
+
+			capture, err := apiServer.CaptureContainer("web")
+
+			if err != nil {
+				return err
+			}
+
+			if lastId != "" && (capture.Id != lastId) != replaced {
+				return fmt.Errorf("expected replaced=%v, container id went from %s to %s", replaced, lastId, capture.Id)
+			}
+
+			lastId = capture.Id
+
+			if capture.Json.Env["VERSION"] != version || !capture.Running {
+				return fmt.Errorf("expected version %s to be running, got %s", version, capture.Json.Env["VERSION"])
+			}
+
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("1", ""),
+				ExpectError: regexp.MustCompile("Invalid attribute combination"),
+			},
+			{
+				// A container that wasn't created for this resource is never replaced
+				Config:      configNamed("api", "1", waitFor),
+				ExpectError: regexp.MustCompile("Container name conflict"),
+			},
+			{
+				PreConfig: func() {
+					capture, err := apiServer.CaptureContainer("api")
+					assert.NilError(t, err)
+					assert.Equal(t, capture.Id, "unrelatedid")
+					assert.Equal(t, capture.Running, true)
+				},
+				Config: config("1", waitFor),
+				Check:  expectVersion("1", false),
+			},
+			{
+				Config: config("2", waitFor),
+				Check: resource.ComposeTestCheckFunc(
+					expectVersion("2", true),
+					func(_ *terraform.State) error {
+						removed := apiServer.Removed[len(apiServer.Removed)-1].Container
+
+						if removed.Json.Name != "web-previous" || removed.Json.Env["VERSION"] != "1" {
+							return fmt.Errorf("expected the old container to be renamed and removed, got %s", removed.Json.Name)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				Config:      config("broken", waitFor),
+				ExpectError: regexp.MustCompile("Container did not become ready"),
+			},
+			{
+				// The old container is left running under its own name after a failed replacement
+				Config: config("2", waitFor),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: expectVersion("2", false),
+			},
+		},
+	})
+}
+
+func TestAccContainerBlueGreenEnabled(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+		OnContainerCreate: func(c *testutil.TestContainer) {
+			c.Health = "healthy"
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(blueGreen bool, version string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "web" {
+				blue_green     = %t
+				container_host = "%s"
+				env            = { VERSION = "%s" }
+				image          = "example.com/library/test:v1.0.0"
+				name           = "web"
+
+				wait_for = {
+					state   = "healthy"
+					timeout = 1
+				}
+
+				lifecycle {
+					create_before_destroy = true
+				}
+			}
+		`, blueGreen, framework.Url(), version)
+	}
+
+	var firstId string
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(false, "1"),
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("web")
+
+					if err != nil {
+						return err
+					}
+
+					firstId = capture.Id
+
+					return nil
+				},
+			},
+			{
+				// Turning blue_green on doesn't replace the container, so it isn't labelled
+				Config: config(true, "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("podman_container.web", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config:      config(true, "2"),
+				ExpectError: regexp.MustCompile("created before blue_green was turned on"),
+			},
+			{
+				// Once the old container has been renamed by hand, the replacement takes its name
+				PreConfig: func() {
+					err := apiServer.ContainerWalk(func(c *testutil.TestContainer) error {
+						if c.Json.Name == "web" {
+							c.Json.Name = "web-previous"
+						}
+
+						return nil
+					})
+					assert.NilError(t, err)
+				},
+				Config: config(true, "2"),
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("web")
+
+					if err != nil {
+						return err
+					}
+
+					if capture.Id == firstId || capture.Json.Env["VERSION"] != "2" || !capture.Running {
+						return fmt.Errorf("expected version 2 to be running in a new container")
+					}
+
+					removed := apiServer.Removed[len(apiServer.Removed)-1].Container
+
+					if removed.Id != firstId || removed.Json.Name != "web-previous" {
+						return fmt.Errorf("expected the renamed container to be removed, got %s", removed.Json.Name)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccContainerNetworks(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+		Networks: []*api.NetworkJson{
+			{
+				Id:   "frontendid",
+				Name: "frontend",
+			},
+			{
+				Id:   "monitoringid",
+				Name: "monitoring",
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(networks string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "web" {
+				container_host = "%s"
+				image          = "example.com/library/test:v1.0.0"
+				name           = "web"
+				networks       = %s
+			}
+		`, framework.Url(), networks)
+	}
+
+	expectNetworks := func(expected map[string]api.ContainerCreateNetworkJson) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			capture, err := apiServer.CaptureContainer("web")
+
+			if err != nil {
+				return err
+			}
+
+			result := cmp.DeepEqual(capture.Json.Networks, expected)()
+
+			if !result.Success() {
+				t.Log(result)
+
+				return fmt.Errorf("incorrect networks")
+			}
+
+			return nil
+		}
+	}
+
+	expectAction := func(action plancheck.ResourceActionType) resource.ConfigPlanChecks {
+		return resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				plancheck.ExpectResourceAction("podman_container.web", action),
+			},
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`[{ id = "frontend" }]`),
+				Check:  expectNetworks(map[string]api.ContainerCreateNetworkJson{"frontend": {}}),
+			},
+			{
+				// Add a network
+				Config:           config(`[{ id = "frontend" }, { id = "monitoring", aliases = ["web-metrics"] }]`),
+				ConfigPlanChecks: expectAction(plancheck.ResourceActionUpdate),
+				Check: expectNetworks(map[string]api.ContainerCreateNetworkJson{
+					"frontend":   {},
+					"monitoring": {Aliases: []string{"web-metrics"}},
+				}),
+			},
+			{
+				// Change a network's options and remove another network
+				Config:           config(`[{ id = "monitoring", aliases = ["metrics"] }]`),
+				ConfigPlanChecks: expectAction(plancheck.ResourceActionUpdate),
+				Check: expectNetworks(map[string]api.ContainerCreateNetworkJson{
+					"monitoring": {Aliases: []string{"metrics"}},
+				}),
+			},
+			{
+				// Switching back to the default network replaces the container
+				Config:           config(`[]`),
+				ConfigPlanChecks: expectAction(plancheck.ResourceActionDestroyBeforeCreate),
+				Check:            expectNetworks(nil),
+			},
+		},
+	})
+}
+
+func TestAccContainerPortRanges(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(extra string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "web" {
+				container_host = "%s"
+				image          = "example.com/library/test:v1.0.0"
+				name           = "web"
+				%s
+
+				port_mappings = [
+					{
+						container_port = 80
+					},
+					{
+						container_port = 8000
+						host_port      = 9000
+						range          = 3
+					},
+					{
+						container_port = 10000
+						protocols      = ["udp"]
+						range          = 2
+					},
+				]
+			}
+		`, framework.Url(), extra)
+	}
+
+	assignedHostPorts := statecheck.ExpectKnownValue(
+		"podman_container.web",
+		tfjsonpath.New("assigned_host_ports"),
+		knownvalue.ListExact([]knownvalue.Check{
+			knownvalue.Int32Exact(40000),
+			knownvalue.Int32Exact(9000),
+			knownvalue.Int32Exact(40001),
+		}),
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			return apiServer.ContainerWalk(func(c *testutil.TestContainer) error {
+				return fmt.Errorf("leftover container: %s", c.Json.Name)
+			})
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "web" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						name           = "web"
+
+						port_mappings = [
+							{
+								container_port = 65000
+								host_port      = 65000
+								range          = 1000
+							}
+						]
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("Invalid port range"),
+			},
+			{
+				Config: config(""),
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("web")
+
+					if err != nil {
+						return err
+					}
+
+					ranges := make([]uint16, 0)
+
+					for _, mapping := range capture.Json.PortMappings {
+						ranges = append(ranges, mapping.Range)
+					}
+
+					result := cmp.DeepEqual(ranges, []uint16{0, 3, 2})()
+
+					if !result.Success() {
+						t.Log(result)
+
+						return fmt.Errorf("incorrect port ranges")
+					}
+
+					return nil
+				},
+				ConfigStateChecks: []statecheck.StateCheck{assignedHostPorts},
+			},
+			{
+				// The assigned ports are kept when the container is updated in place
+				Config: config("remove_volumes = true"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("podman_container.web", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{assignedHostPorts},
+			},
+		},
+	})
+}
+
+func TestAccContainerSecurity(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(security string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "web" {
+				container_host = "%s"
+				image          = "example.com/library/test:v1.0.0"
+				name           = "web"
+				security       = %s
+			}
+		`, framework.Url(), security)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`{ cap_drop = ["chown"] }`),
+				ExpectError: regexp.MustCompile("must be a capability name"),
+			},
+			{
+				Config:      config(`{ read_only_tmpfs = false }`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config:      config(`{ seccomp_profile_path = "seccomp.json" }`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: config(`{
+					apparmor_profile     = "containers-default-0.50.1"
+					cap_add              = ["CAP_NET_BIND_SERVICE"]
+					cap_drop             = ["ALL"]
+					masked_paths         = ["/sys/firmware"]
+					no_new_privileges    = true
+					read_only_rootfs     = true
+					read_only_tmpfs      = false
+					seccomp_profile_path = "/etc/containers/seccomp.json"
+					unmasked_paths       = ["/proc/acpi"]
+				}`),
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("web")
+
+					if err != nil {
+						return err
+					}
+
+					yes := true
+					no := false
+					want := api.ContainerCreateJson{
+						ApparmorProfile:    "containers-default-0.50.1",
+						CapAdd:             []string{"CAP_NET_BIND_SERVICE"},
+						CapDrop:            []string{"ALL"},
+						Mask:               []string{"/sys/firmware"},
+						NoNewPrivileges:    &yes,
+						ReadOnlyFilesystem: &yes,
+						ReadWriteTmpfs:     &no,
+						SeccompProfilePath: "/etc/containers/seccomp.json",
+						Unmask:             []string{"/proc/acpi"},
+					}
+					got := api.ContainerCreateJson{
+						ApparmorProfile:    capture.Json.ApparmorProfile,
+						CapAdd:             capture.Json.CapAdd,
+						CapDrop:            capture.Json.CapDrop,
+						Mask:               capture.Json.Mask,
+						NoNewPrivileges:    capture.Json.NoNewPrivileges,
+						Privileged:         capture.Json.Privileged,
+						ReadOnlyFilesystem: capture.Json.ReadOnlyFilesystem,
+						ReadWriteTmpfs:     capture.Json.ReadWriteTmpfs,
+						SeccompProfilePath: capture.Json.SeccompProfilePath,
+						Unmask:             capture.Json.Unmask,
+					}
+					result := cmp.DeepEqual(got, want)()
+
+					if !result.Success() {
+						t.Log(result)
+
+						return fmt.Errorf("incorrect security configuration")
+					}
+
+					return nil
+				},
+			},
+			{
+				Config: config(`{ privileged = true }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("podman_container.web", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("web")
+
+					if err != nil {
+						return err
+					}
+
+					if capture.Json.Privileged == nil || !*capture.Json.Privileged || capture.Json.ReadOnlyFilesystem != nil {
+						return fmt.Errorf("incorrect security configuration after replacement")
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccContainerHostSettings(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := fmt.Sprintf(`
+		resource "podman_container" "legacy" {
+			container_host = "%s"
+			dns_options    = ["ndots:2"]
+			dns_search     = []
+			dns_servers    = ["192.0.2.53", "2001:db8::53"]
+			hostname       = "legacy01"
+			image          = "example.com/library/test:v1.0.0"
+			name           = "legacy"
+
+			extra_hosts = [
+				{
+					hostname = "db.internal"
+					ip       = "192.0.2.10"
+				},
+				{
+					hostname = "db.internal"
+					ip       = "2001:db8::10"
+				},
+			]
+		}
+	`, framework.Url())
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "legacy" {
+						container_host = "%s"
+						hostname       = "-legacy"
+						image          = "example.com/library/test:v1.0.0"
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("must be a valid hostname"),
+			},
+			{
+				Config: config,
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("legacy")
+
+					if err != nil {
+						return err
+					}
+
+					want := api.ContainerCreateJson{
+						DnsOption: []string{"ndots:2"},
+						DnsServer: []string{"192.0.2.53", "2001:db8::53"},
+						HostAdd:   []string{"db.internal:192.0.2.10", "db.internal:2001:db8::10"},
+						Hostname:  "legacy01",
+					}
+					got := api.ContainerCreateJson{
+						DnsOption: capture.Json.DnsOption,
+						DnsSearch: capture.Json.DnsSearch,
+						DnsServer: capture.Json.DnsServer,
+						HostAdd:   capture.Json.HostAdd,
+						Hostname:  capture.Json.Hostname,
+					}
+					result := cmp.DeepEqual(got, want)()
+
+					if !result.Success() {
+						t.Log(result)
+
+						return fmt.Errorf("incorrect host settings")
+					}
+
+					return nil
+				},
+			},
+			{
+				// An empty list is not mistaken for drift
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				PreConfig: func() {
+					_ = apiServer.ContainerWalk(func(c *testutil.TestContainer) error {
+						c.Json.HostAdd = c.Json.HostAdd[:1]
+
+						return nil
+					})
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("podman_container.legacy", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccContainerKernelLimits(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(extra string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "db" {
+				container_host = "%s"
+				image          = "example.com/library/test:v1.0.0"
+				name           = "db"
+				%s
+			}
+		`, framework.Url(), extra)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`sysctls = { "vm.swappiness" = "10" }`),
+				ExpectError: regexp.MustCompile("Unsupported sysctl"),
+			},
+			{
+				Config: config(`
+					network_namespace = { mode = "host" }
+					sysctls           = { "net.core.somaxconn" = "1024" }
+				`),
+				ExpectError: regexp.MustCompile("Invalid attribute combination"),
+			},
+			{
+				Config:      config(`ulimits = [{ name = "nofile", soft = 65536, hard = 1024 }]`),
+				ExpectError: regexp.MustCompile("Invalid ulimit"),
+			},
+			{
+				Config: config(`
+					ulimits = [
+						{ name = "nofile", soft = 1024, hard = 1024 },
+						{ name = "nofile", soft = 2048, hard = 2048 },
+					]
+				`),
+				ExpectError: regexp.MustCompile("Duplicate ulimit"),
+			},
+			{
+				Config: config(`
+					network_namespace = { mode = "host" }
+					sysctls           = { "kernel.shmmax" = "68719476736" }
+				`),
+			},
+			{
+				Config: config(`
+					sysctls = {
+						"fs.mqueue.msg_max"  = "64"
+						"net.core.somaxconn" = "1024"
+					}
+
+					ulimits = [
+						{ name = "nofile", soft = 65536, hard = 65536 },
+						{ name = "memlock", soft = -1, hard = -1 },
+					]
+				`),
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("db")
+
+					if err != nil {
+						return err
+					}
+
+					result := cmp.DeepEqual(
+						[]any{capture.Json.Sysctl, capture.Json.RLimits},
+						[]any{
+							map[string]string{
+								"fs.mqueue.msg_max":  "64",
+								"net.core.somaxconn": "1024",
+							},
+							[]api.ContainerCreateRlimitJson{
+								{Hard: 65536, Soft: 65536, Type: "RLIMIT_NOFILE"},
+								{Hard: math.MaxUint64, Soft: math.MaxUint64, Type: "RLIMIT_MEMLOCK"},
+							},
+						},
+					)()
+
+					if !result.Success() {
+						t.Log(result)
+
+						return fmt.Errorf("incorrect kernel limits")
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccContainerLogging(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(extra string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "proxy" {
+				container_host = "%s"
+				image          = "example.com/library/test:v1.0.0"
+				name           = "proxy"
+				%s
+			}
+		`, framework.Url(), extra)
+	}
+
+	fileConfig := config(`
+		log_driver = "k8s-file"
+
+		log_options = {
+			max_size = 10485760
+			path     = "/var/log/containers/proxy.log"
+			tag      = "proxy"
+		}
+	`)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`log_driver = "syslog"`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: config(`
+					log_driver  = "journald"
+					log_options = { path = "/var/log/proxy.log" }
+				`),
+				ExpectError: regexp.MustCompile("not supported by the journald log driver"),
+			},
+			{
+				Config: config(`
+					log_driver = "none"
+					wait_for   = { log_pattern = "ready" }
+				`),
+				ExpectError: regexp.MustCompile("Podman does not keep the container's logs"),
+			},
+			{
+				Config: fileConfig,
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("proxy")
+
+					if err != nil {
+						return err
+					}
+
+					want := &api.ContainerCreateLogConfigJson{
+						Driver:  "k8s-file",
+						Options: map[string]string{"tag": "proxy"},
+						Path:    "/var/log/containers/proxy.log",
+						Size:    10485760,
+					}
+					result := cmp.DeepEqual(capture.Json.LogConfiguration, want)()
+
+					if !result.Success() {
+						t.Log(result)
+
+						return fmt.Errorf("incorrect log configuration")
+					}
+
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					_ = apiServer.ContainerWalk(func(c *testutil.TestContainer) error {
+						c.Json.LogConfiguration.Driver = "journald"
+
+						return nil
+					})
+				},
+				Config: fileConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("podman_container.proxy", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+			{
+				Config: fileConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccContainerMounts(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := func(mounts string) string {
+		return fmt.Sprintf(`
+			resource "podman_container" "app" {
+				container_host = "%s"
+				image          = "example.com/library/test:v1.0.0"
+				mounts         = %s
+				name           = "app"
+				volumes_from   = ["data:ro"]
+			}
+		`, framework.Url(), mounts)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`[{ type = "tmpfs", target = "/tmp", subpath = "cache" }]`),
+				ExpectError: regexp.MustCompile("subpath can not be set on a tmpfs mount"),
+			},
+			{
+				Config:      config(`[{ target = "/srv" }]`),
+				ExpectError: regexp.MustCompile("source must be set on a bind mount"),
+			},
+			{
+				Config:      config(`[{ type = "ramfs", target = "/run/app", read_only = true }]`),
+				ExpectError: regexp.MustCompile("read_only can not be set on a ramfs mount"),
+			},
+			{
+				Config: config(`[
+					{
+						propagation = "rslave"
+						read_only   = true
+						source      = "/srv/config"
+						target      = "/etc/app"
+					},
+					{
+						chown   = true
+						idmap   = true
+						options = ["Z"]
+						source  = "appdata"
+						subpath = "v2"
+						target  = "/var/lib/app"
+						type    = "volume"
+					},
+					{
+						target = "/var/cache/app"
+						type   = "volume"
+					},
+					{
+						mode   = parseint("1777", 8)
+						size   = 67108864
+						target = "/tmp"
+						type   = "tmpfs"
+					},
+					{
+						read_only = false
+						source    = "example.com/library/assets:v1"
+						subpath   = "/public"
+						target    = "/srv/www"
+						type      = "image"
+					},
+					{
+						source = "/srv/seed"
+						target = "/seed"
+						type   = "overlay"
+					},
+					{
+						mode   = parseint("620", 8)
+						target = "/dev/pts"
+						type   = "devpts"
+					},
+					{
+						options = ["size=1m"]
+						target  = "/run/app"
+						type    = "ramfs"
+					},
+				]`),
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("app")
+
+					if err != nil {
+						return err
+					}
+
+					want := api.ContainerCreateJson{
+						ImageVolumes: []api.ContainerCreateImageVolumeJson{
+							{
+								Destination: "/srv/www",
+								ReadWrite:   true,
+								Source:      "example.com/library/assets:v1",
+								SubPath:     "/public",
+							},
+						},
+						Mounts: []api.ContainerCreateMountJson{
+							{
+								Destination: "/etc/app",
+								Options:     []string{"rslave", "ro"},
+								Source:      "/srv/config",
+								Type:        "bind",
+							},
+							{
+								Destination: "/tmp",
+								Options:     []string{"mode=1777", "size=67108864"},
+								Type:        "tmpfs",
+							},
+							{
+								Destination: "/dev/pts",
+								Options:     []string{"mode=620"},
+								Type:        "devpts",
+							},
+							{
+								Destination: "/run/app",
+								Options:     []string{"size=1m"},
+								Type:        "ramfs",
+							},
+						},
+						OverlayVolumes: []api.ContainerCreateOverlayVolumeJson{
+							{
+								Destination: "/seed",
+								Source:      "/srv/seed",
+							},
+						},
+						Volumes: []api.ContainerCreateNamedVolumeJson{
+							{
+								Dest:    "/var/lib/app",
+								Name:    "appdata",
+								Options: []string{"U", "idmap", "Z"},
+								SubPath: "v2",
+							},
+							{
+								Dest:        "/var/cache/app",
+								IsAnonymous: true,
+							},
+						},
+						VolumesFrom: []string{"data:ro"},
+					}
+					got := api.ContainerCreateJson{
+						ImageVolumes:   capture.Json.ImageVolumes,
+						Mounts:         capture.Json.Mounts,
+						OverlayVolumes: capture.Json.OverlayVolumes,
+						Volumes:        capture.Json.Volumes,
+						VolumesFrom:    capture.Json.VolumesFrom,
+					}
+					result := cmp.DeepEqual(got, want)()
+
+					if !result.Success() {
+						t.Log(result)
+
+						return fmt.Errorf("incorrect mounts")
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccContainerProcessSettings(t *testing.T) {
+	apiServer := testutil.ApiServer{
+		Images: []*api.ImageJson{
+			{
+				Id:    "imageid",
+				Names: []string{"example.com/library/test:v1.0.0"},
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	config := fmt.Sprintf(`
+		resource "podman_container" "worker" {
+			container_host = "%s"
+			env_host       = true
+			image          = "example.com/library/test:v1.0.0"
+			init           = true
+			init_path      = "/usr/libexec/podman/catatonit"
+			name           = "worker"
+			timezone       = "Europe/London"
+			umask          = "027"
+			unsetenv       = ["HOME"]
+			working_dir    = "/srv/app"
+		}
+	`, framework.Url())
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "worker" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						init_path      = "/usr/libexec/podman/catatonit"
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "podman_container" "worker" {
+						container_host = "%s"
+						image          = "example.com/library/test:v1.0.0"
+						umask          = "0o27"
+					}
+				`, framework.Url()),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: config,
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("worker")
+
+					if err != nil {
+						return err
+					}
+
+					yes := true
+					want := api.ContainerCreateJson{
+						EnvHost:  &yes,
+						Init:     &yes,
+						InitPath: "/usr/libexec/podman/catatonit",
+						Timezone: "Europe/London",
+						Umask:    "027",
+						Unsetenv: []string{"HOME"},
+						WorkDir:  "/srv/app",
+					}
+					got := api.ContainerCreateJson{
+						EnvHost:  capture.Json.EnvHost,
+						Init:     capture.Json.Init,
+						InitPath: capture.Json.InitPath,
+						Timezone: capture.Json.Timezone,
+						Umask:    capture.Json.Umask,
+						Unsetenv: capture.Json.Unsetenv,
+						WorkDir:  capture.Json.WorkDir,
+					}
+					result := cmp.DeepEqual(got, want)()
+
+					if !result.Success() {
+						t.Log(result)
+
+						return fmt.Errorf("incorrect process settings")
+					}
+
+					return nil
+				},
+			},
+			{
+				// Podman reports the umask with a leading zero
+				PreConfig: func() {
+					_ = apiServer.ContainerWalk(func(c *testutil.TestContainer) error {
+						c.Json.Umask = "0027"
+
+						return nil
+					})
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				PreConfig: func() {
+					_ = apiServer.ContainerWalk(func(c *testutil.TestContainer) error {
+						c.Json.WorkDir = "/"
+
+						return nil
+					})
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("podman_container.worker", plancheck.ResourceActionReplace),
+					},
+				},
+			},
 		},
 	})
 }
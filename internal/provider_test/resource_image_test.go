@@ -10,6 +10,7 @@ import (
 	"github.com/decafcode/terraform-provider-podman/internal/testutil"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -106,6 +107,47 @@ func TestAccImageResource(t *testing.T) {
 
 				`, framework.Url(), keepRef),
 			},
+			{
+				// Import by reference using an identity, which should resolve to the image ID
+				Config: fmt.Sprintf(`
+					import {
+						to = podman_image.import_test
+
+						identity = {
+							container_host = "%s"
+							id             = "%s"
+						}
+					}
+
+					resource "podman_image" "preserve_test" {
+						container_host = "%s"
+						policy         = "missing"
+						preserve       = true
+						pull_number    = 1
+						reference      = "%s"
+					}
+
+					resource "podman_image" "import_test" {
+						container_host = "%s"
+						preserve       = true
+						reference      = "%s"
+					}
+				`, framework.Url(), keepRef, framework.Url(), keepRef, framework.Url(), keepRef),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"podman_image.import_test",
+						tfjsonpath.New("id"),
+						"podman_image.preserve_test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+					statecheck.ExpectKnownValue(
+						"podman_image.import_test",
+						tfjsonpath.New("reference"),
+						knownvalue.StringExact(keepRef),
+					),
+				},
+			},
 		},
 	})
 }
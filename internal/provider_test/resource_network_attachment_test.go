@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+	"github.com/decafcode/terraform-provider-podman/internal/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func TestAccNetworkAttachmentResource(t *testing.T) {
+	c := &testutil.TestContainer{
+		Id: "webid",
+		Json: api.ContainerCreateJson{
+			Image:    "imageid",
+			Name:     "web",
+			Networks: map[string]api.ContainerCreateNetworkJson{"loggingid": {}},
+		},
+	}
+
+	apiServer := testutil.ApiServer{
+		Containers: []*testutil.TestContainer{c},
+		Networks: []*api.NetworkJson{
+			{
+				Id:   "loggingid",
+				Name: "logging",
+			},
+			{
+				Id:   "monitoringid",
+				Name: "monitoring",
+			},
+		},
+	}
+
+	framework, err := spawnFramework(t.Context(), &apiServer)
+	assert.NilError(t, err)
+
+	defer framework.Stop(t.Context())
+
+	monitoring := fmt.Sprintf(`
+		resource "podman_network_attachment" "monitoring" {
+			aliases        = ["web-metrics"]
+			container      = "web"
+			container_host = "%s"
+			ipv4_address   = "10.89.1.10"
+			network        = "monitoringid"
+		}
+	`, framework.Url())
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: providerFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if len(c.Json.Networks) != 0 {
+				return fmt.Errorf("leftover networks: %v", c.Json.Networks)
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitoring,
+				Check: func(_ *terraform.State) error {
+					capture, err := apiServer.CaptureContainer("web")
+
+					if err != nil {
+						return err
+					}
+
+					result := cmp.DeepEqual(capture.Json.Networks["monitoring"], api.ContainerCreateNetworkJson{
+						Aliases:   []string{"web-metrics"},
+						StaticIPs: []string{"10.89.1.10"},
+					})()
+
+					if !result.Success() {
+						t.Log(result)
+
+						return fmt.Errorf("incorrect network options")
+					}
+
+					return nil
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"podman_network_attachment.monitoring",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("web/monitoring"),
+					),
+				},
+			},
+			{
+				// Import the attachment that the container was created with
+				Config: monitoring + fmt.Sprintf(`
+					import {
+						to = podman_network_attachment.logging
+
+						identity = {
+							container_host = "%s"
+							id             = "web/logging"
+						}
+					}
+
+					resource "podman_network_attachment" "logging" {
+						container      = "web"
+						container_host = "%s"
+						network        = "logging"
+					}
+				`, framework.Url(), framework.Url()),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(
+						"podman_network_attachment.logging",
+						map[string]knownvalue.Check{
+							"container_host": knownvalue.StringExact(framework.Url()),
+							"id":             knownvalue.StringExact("web/logging"),
+						},
+					),
+				},
+			},
+			{
+				// Recreating the container under the same name doesn't change the identity
+				PreConfig: func() {
+					c.Id = "newwebid"
+				},
+				Config: monitoring,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(
+						"podman_network_attachment.monitoring",
+						map[string]knownvalue.Check{
+							"container_host": knownvalue.StringExact(framework.Url()),
+							"id":             knownvalue.StringExact("web/monitoring"),
+						},
+					),
+				},
+			},
+		},
+	})
+}
@@ -96,6 +96,38 @@ func TestAccNetworkResource(t *testing.T) {
 				ImportState:   true,
 				ImportStateId: fmt.Sprintf("%s,%s", n.Id, framework.Url()),
 			},
+			{
+				// Import by name using an identity, which should resolve to the network's ID
+				Config: fmt.Sprintf(`
+					import {
+						to = podman_network.identity_test
+
+						identity = {
+							container_host = "%s"
+							id             = "importtest"
+						}
+					}
+
+					resource "podman_network" "identity_test" {
+						container_host = "%s"
+						name           = "importtest"
+					}
+				`, framework.Url(), framework.Url()),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"podman_network.identity_test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact(n.Id),
+					),
+					statecheck.ExpectIdentity(
+						"podman_network.identity_test",
+						map[string]knownvalue.Check{
+							"container_host": knownvalue.StringExact(framework.Url()),
+							"id":             knownvalue.StringExact(n.Id),
+						},
+					),
+				},
+			},
 		},
 	})
 }
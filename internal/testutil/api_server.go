@@ -24,23 +24,72 @@ type TestUpload struct {
 }
 
 type TestContainer struct {
+	// Health status reported by inspect while the container is running, if non-empty
+	Health    string
 	Id        string
 	Json      api.ContainerCreateJson
+	ErrorLogs []string
+	Exited    bool
+	ExitCode  *int
+	HealthLog []api.ContainerInspectHealthLogJson
+	Logs      []string
+	Paused    bool
+	Restarts  int
 	Running   bool
+	SignalLog []string
+	StopLog   []string
+	Updates   int
 	UploadLog []TestUpload
 }
 
+// The container's state in the format used by libpod's inspect and list endpoints. Paused
+// containers are also considered to be running.
+func (c *TestContainer) status() string {
+	switch {
+	case c.Paused:
+		return "paused"
+	case c.Running:
+		return "running"
+	case c.Exited:
+		return "exited"
+	default:
+		return "created"
+	}
+}
+
+// Returns a failed prune report if the object has been set up to fail to be pruned.
+func (s *ApiServer) pruneFailure(id string) (api.PruneReportJson, bool) {
+	message, ok := s.PruneErrors[id]
+
+	return api.PruneReportJson{Err: &message, Id: id}, ok
+}
+
+type RemovedContainer struct {
+	Container *TestContainer
+	Volumes   bool
+}
+
+// ApiVersion is reported by the ping endpoint, and defaults to a recent version of Podman.
+// OnContainerCreate is called with each newly created container, so that tests can simulate its
+// behaviour. PruneErrors maps the IDs of containers, images and volumes that should fail to be
+// pruned to an error message. Removed records every container that has been deleted.
 type ApiServer struct {
-	Auth            *api.RegistryAuth
-	Containers      []*TestContainer
-	Images          []*api.ImageJson
-	Networks        []*api.NetworkJson
-	PullRequests    []PullRequest
-	Secrets         []*api.SecretInspectJson
-	ValidReferences map[string]bool
-
-	mutex  sync.Mutex
-	nextId int
+	ApiVersion        string
+	Auth              *api.RegistryAuth
+	Containers        []*TestContainer
+	Images            []*api.ImageJson
+	Networks          []*api.NetworkJson
+	OnContainerCreate func(c *TestContainer)
+	PruneErrors       map[string]string
+	PullRequests      []PullRequest
+	Removed           []RemovedContainer
+	Secrets           []*api.SecretInspectJson
+	ValidReferences   map[string]bool
+	Volumes           []*api.VolumeJson
+
+	mutex    sync.Mutex
+	nextId   int
+	nextPort uint16
 }
 
 func writeJson(resp http.ResponseWriter, v any) error {
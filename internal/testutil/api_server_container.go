@@ -4,10 +4,13 @@ import (
 	"archive/tar"
 	"context"
 	"encoding/base64"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"net/http"
 	"slices"
+	"strconv"
+	"time"
 
 	"github.com/decafcode/terraform-provider-podman/internal/api"
 )
@@ -25,6 +28,55 @@ func (s *ApiServer) lookupContainer(nameOrId string) (*TestContainer, error) {
 	}
 }
 
+// Replaces host ports of zero with ports from an arbitrary ephemeral range, like Podman does when
+// a container is created.
+func (s *ApiServer) allocatePorts(mappings []api.ContainerCreatePortMappingJson) {
+	if s.nextPort == 0 {
+		s.nextPort = 40000
+	}
+
+	for i := range mappings {
+		if mappings[i].HostPort == 0 {
+			mappings[i].HostPort = s.nextPort
+			s.nextPort += max(mappings[i].Range, 1)
+		}
+	}
+}
+
+// Names are optional, but must be unique among the containers that have one.
+func (s *ApiServer) checkContainerName(name string) error {
+	for _, c := range s.Containers {
+		if name != "" && c.Json.Name == name {
+			return statusError{
+				Code:    http.StatusConflict,
+				Message: fmt.Sprintf("the container name \"%s\" is already in use by %s", name, c.Id),
+			}
+		}
+	}
+
+	return nil
+}
+
+// Containers that were not created with any networks are not reported as being on Podman's
+// default network.
+func (s *ApiServer) containerNetworkNames(c *TestContainer) []string {
+	var names []string
+
+	for key := range c.Json.Networks {
+		n, err := s.lookupNetwork(key)
+
+		if err == nil {
+			names = append(names, n.Name)
+		} else {
+			names = append(names, key)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
 func (s *ApiServer) handleContainerArchive(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -89,10 +141,35 @@ func (s *ApiServer) handleContainerCreate(ctx context.Context, resp http.Respons
 		return err
 	}
 
+	err = s.checkContainerName(c.Json.Name)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = s.lookupImage(c.Json.Image)
+
+	if err != nil {
+		return err
+	}
+
+	for network := range c.Json.Networks {
+		_, err = s.lookupNetwork(network)
+
+		if err != nil {
+			return err
+		}
+	}
+
 	s.nextId++
 	c.Id = fmt.Sprintf("%d", s.nextId)
+	s.allocatePorts(c.Json.PortMappings)
 	s.Containers = append(s.Containers, c)
 
+	if s.OnContainerCreate != nil {
+		s.OnContainerCreate(c)
+	}
+
 	result := &api.ContainerCreatedJson{Id: c.Id}
 
 	return writeJson(resp, result)
@@ -103,12 +180,17 @@ func (s *ApiServer) handleContainerDelete(ctx context.Context, resp http.Respons
 	defer s.mutex.Unlock()
 
 	nameOrId := req.PathValue("nameOrId")
-	_, err := s.lookupContainer(nameOrId)
+	match, err := s.lookupContainer(nameOrId)
 
 	if err != nil {
 		return nil
 	}
 
+	s.Removed = append(s.Removed, RemovedContainer{
+		Container: match,
+		Volumes:   req.URL.Query().Get("v") == "true",
+	})
+
 	s.Containers = slices.DeleteFunc(s.Containers, func(c *TestContainer) bool {
 		return c.Json.Name == nameOrId || c.Id == nameOrId
 	})
@@ -127,7 +209,248 @@ func (s *ApiServer) handleContainerGet(ctx context.Context, resp http.ResponseWr
 		return err
 	}
 
-	result := api.ContainerInspectJson{Name: match.Json.Name}
+	result := api.ContainerInspectJson{
+		Config: api.ContainerInspectConfigJson{
+			Hostname:   match.Json.Hostname,
+			Labels:     match.Json.Labels,
+			Timezone:   match.Json.Timezone,
+			Umask:      match.Json.Umask,
+			WorkingDir: match.Json.WorkDir,
+		},
+		HostConfig: api.ContainerInspectHostConfigJson{
+			Dns:        match.Json.DnsServer,
+			DnsOptions: match.Json.DnsOption,
+			DnsSearch:  match.Json.DnsSearch,
+			ExtraHosts: match.Json.HostAdd,
+			Init:       match.Json.Init != nil && *match.Json.Init,
+			LogConfig: api.ContainerInspectLogConfigJson{
+				Type: "journald",
+			},
+		},
+		Id:    match.Id,
+		Image: match.Json.Image,
+		Name:  match.Json.Name,
+		NetworkSettings: api.ContainerInspectNetworkSettingsJson{
+			Networks: make(map[string]api.ContainerInspectNetworkJson),
+		},
+		State: api.ContainerInspectStateJson{
+			Running: match.Running,
+			Status:  match.status(),
+		},
+	}
+
+	if log := match.Json.LogConfiguration; log != nil {
+		result.HostConfig.LogConfig = api.ContainerInspectLogConfigJson{
+			Path: log.Path,
+			Tag:  log.Options["tag"],
+			Type: log.Driver,
+		}
+	}
+
+	// Podman defaults the hostname to the start of the container's ID, and the umask and working
+	// directory to those of the image
+	if result.Config.Hostname == "" {
+		result.Config.Hostname = match.Id
+	}
+
+	if result.Config.Umask == "" {
+		result.Config.Umask = "0022"
+	}
+
+	if result.Config.WorkingDir == "" {
+		result.Config.WorkingDir = "/"
+	}
+
+	for _, name := range s.containerNetworkNames(match) {
+		result.NetworkSettings.Networks[name] = api.ContainerInspectNetworkJson{}
+	}
+
+	if match.Running {
+		if match.Health != "" {
+			result.State.Health = &api.ContainerInspectHealthJson{
+				Log:    match.HealthLog,
+				Status: match.Health,
+			}
+		}
+	}
+
+	return writeJson(resp, result)
+}
+
+func (s *ApiServer) handleContainerList(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	filters, err := readFilters(req, "id", "label", "name", "status")
+
+	if err != nil {
+		return err
+	}
+
+	result := make([]api.ContainerListJson, 0)
+
+	for _, c := range s.Containers {
+		state := c.status()
+
+		if !filters.matchValue("id", c.Id) || !filters.matchNames("name", c.Json.Name) || !filters.matchLabels(c.Json.Labels) || !filters.matchValue("status", state) {
+			continue
+		}
+
+		imageId := c.Json.Image
+		img, err := s.lookupImage(c.Json.Image)
+
+		if err == nil {
+			imageId = img.Id
+		}
+
+		result = append(result, api.ContainerListJson{
+			Id:       c.Id,
+			Image:    c.Json.Image,
+			ImageID:  imageId,
+			Labels:   c.Json.Labels,
+			Names:    []string{c.Json.Name},
+			Networks: s.containerNetworkNames(c),
+			Ports:    c.Json.PortMappings,
+			State:    state,
+		})
+	}
+
+	return writeJson(resp, result)
+}
+
+// Only SIGKILL and SIGTERM are assumed to terminate the container.
+func (s *ApiServer) handleContainerKill(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nameOrId := req.PathValue("nameOrId")
+	match, err := s.lookupContainer(nameOrId)
+
+	if err != nil {
+		return err
+	}
+
+	if !match.Running {
+		return statusError{
+			Code:    http.StatusConflict,
+			Message: "can only kill running containers",
+		}
+	}
+
+	signal := req.URL.Query().Get("signal")
+
+	if signal == "" {
+		signal = "SIGKILL"
+	}
+
+	match.SignalLog = append(match.SignalLog, signal)
+
+	if signal == "SIGKILL" || signal == "SIGTERM" {
+		match.stop()
+	}
+
+	return nil
+}
+
+// Logs are multiplexed as for a container without a terminal, with all of the stdout lines
+// followed by all of the stderr lines.
+func (s *ApiServer) handleContainerLogs(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nameOrId := req.PathValue("nameOrId")
+	match, err := s.lookupContainer(nameOrId)
+
+	if err != nil {
+		return err
+	}
+
+	type frame struct {
+		line   string
+		stream byte
+	}
+
+	frames := make([]frame, 0)
+
+	for _, line := range match.Logs {
+		frames = append(frames, frame{line, 1})
+	}
+
+	for _, line := range match.ErrorLogs {
+		frames = append(frames, frame{line, 2})
+	}
+
+	tail, err := strconv.Atoi(req.URL.Query().Get("tail"))
+
+	if err == nil && tail >= 0 && tail < len(frames) {
+		frames = frames[len(frames)-tail:]
+	}
+
+	for _, f := range frames {
+		header := make([]byte, 8)
+		header[0] = f.stream
+		binary.BigEndian.PutUint32(header[4:], uint32(len(f.line)+1))
+
+		_, err = resp.Write(append(header, f.line+"\n"...))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ApiServer) handleContainerPause(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nameOrId := req.PathValue("nameOrId")
+	match, err := s.lookupContainer(nameOrId)
+
+	if err != nil {
+		return err
+	}
+
+	if !match.Running || match.Paused {
+		return statusError{
+			Code:    http.StatusInternalServerError,
+			Message: fmt.Sprintf("container state improper: %s", match.status()),
+		}
+	}
+
+	match.Paused = true
+
+	return nil
+}
+
+func (s *ApiServer) handleContainerPrune(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	filters, err := readFilters(req, "label")
+
+	if err != nil {
+		return err
+	}
+
+	result := make([]api.PruneReportJson, 0)
+
+	s.Containers = slices.DeleteFunc(s.Containers, func(c *TestContainer) bool {
+		if c.Running || !filters.matchLabels(c.Json.Labels) {
+			return false
+		}
+
+		if failure, ok := s.pruneFailure(c.Id); ok {
+			result = append(result, failure)
+
+			return false
+		}
+
+		result = append(result, api.PruneReportJson{Id: c.Id})
+
+		return true
+	})
 
 	return writeJson(resp, result)
 }
@@ -153,11 +476,36 @@ func (s *ApiServer) handleContainerRename(ctx context.Context, resp http.Respons
 		return err
 	}
 
+	err = s.checkContainerName(newName)
+
+	if err != nil {
+		return err
+	}
+
 	match.Json.Name = newName
 
 	return nil
 }
 
+func (s *ApiServer) handleContainerRestart(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nameOrId := req.PathValue("nameOrId")
+	match, err := s.lookupContainer(nameOrId)
+
+	if err != nil {
+		return err
+	}
+
+	match.Restarts++
+	match.Exited = false
+	match.Paused = false
+	match.Running = true
+
+	return nil
+}
+
 func (s *ApiServer) handleContainerStart(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -173,8 +521,14 @@ func (s *ApiServer) handleContainerStart(ctx context.Context, resp http.Response
 		resp.WriteHeader(http.StatusNotModified)
 	}
 
+	match.Exited = false
 	match.Running = true
 
+	// Containers with an exit code run to completion immediately
+	if match.ExitCode != nil {
+		match.stop()
+	}
+
 	return nil
 }
 
@@ -195,11 +549,147 @@ func (s *ApiServer) handleContainerStop(ctx context.Context, resp http.ResponseW
 		resp.WriteHeader(http.StatusNotModified)
 	}
 
-	match.Running = false
+	match.StopLog = append(match.StopLog, query.Get("t"))
+	match.stop()
 
 	return nil
 }
 
+func (s *ApiServer) handleContainerUnpause(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nameOrId := req.PathValue("nameOrId")
+	match, err := s.lookupContainer(nameOrId)
+
+	if err != nil {
+		return err
+	}
+
+	if !match.Paused {
+		return statusError{
+			Code:    http.StatusInternalServerError,
+			Message: fmt.Sprintf("container state improper: %s", match.status()),
+		}
+	}
+
+	match.Paused = false
+
+	return nil
+}
+
+func (s *ApiServer) handleContainerUpdate(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nameOrId := req.PathValue("nameOrId")
+	match, err := s.lookupContainer(nameOrId)
+
+	if err != nil {
+		return err
+	}
+
+	var in api.ContainerCreateResourceLimitsJson
+	err = readJson(req, &in)
+
+	if err != nil {
+		return err
+	}
+
+	// Like libpod, only the limits that are present in the request are changed
+	limits := api.ContainerCreateResourceLimitsJson{}
+
+	if match.Json.ResourceLimits != nil {
+		limits = *match.Json.ResourceLimits
+	}
+
+	if in.BlockIO != nil {
+		limits.BlockIO = in.BlockIO
+	}
+
+	if in.CPU != nil {
+		limits.CPU = in.CPU
+	}
+
+	if in.Memory != nil {
+		limits.Memory = in.Memory
+	}
+
+	if in.Pids != nil {
+		limits.Pids = in.Pids
+	}
+
+	match.Json.ResourceLimits = &limits
+
+	query := req.URL.Query()
+
+	if query.Has("restartPolicy") {
+		match.Json.RestartPolicy = query.Get("restartPolicy")
+		match.Json.RestartTries = nil
+	}
+
+	if query.Has("restartRetries") {
+		var retries uint
+		_, err = fmt.Sscanf(query.Get("restartRetries"), "%d", &retries)
+
+		if err != nil {
+			return statusError{Code: http.StatusBadRequest, Message: err.Error()}
+		}
+
+		match.Json.RestartTries = &retries
+	}
+
+	match.Updates++
+
+	return writeJson(resp, match.Id)
+}
+
+// Containers never exit of their own accord unless they have an ExitCode, so waiting for a
+// running container is an error rather than a deadlock.
+func (s *ApiServer) handleContainerWait(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nameOrId := req.PathValue("nameOrId")
+	match, err := s.lookupContainer(nameOrId)
+
+	if err != nil {
+		return err
+	}
+
+	// Containers without an exit code keep running until they are stopped
+	for match.Running {
+		s.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			s.mutex.Lock()
+
+			return statusError{
+				Code:    http.StatusInternalServerError,
+				Message: "test container did not exit",
+			}
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		s.mutex.Lock()
+	}
+
+	exitCode := 0
+
+	if match.ExitCode != nil {
+		exitCode = *match.ExitCode
+	}
+
+	return writeJson(resp, exitCode)
+}
+
+func (c *TestContainer) stop() {
+	c.Exited = c.Exited || c.Running
+	c.Paused = false
+	c.Running = false
+}
+
 // Snapshot the internal state of a container spec inside the test API server
 // and return a copy. This mostly adheres to the JSON format of a container
 // create request rather than using the response format of a container inspect
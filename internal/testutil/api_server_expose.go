@@ -11,21 +11,39 @@ func (s *ApiServer) Expose(baseURL *url.URL, timeout time.Duration) http.Handler
 
 	mux.HandleFunc("GET", "v5.0.0/libpod/_ping", s.handlePing)
 	mux.HandleFunc("POST", "v5.0.0/libpod/containers/create", s.handleContainerCreate)
+	mux.HandleFunc("GET", "v5.0.0/libpod/containers/json", s.handleContainerList)
+	mux.HandleFunc("POST", "v5.0.0/libpod/containers/prune", s.handleContainerPrune)
 	mux.HandleFunc("DELETE", "v5.0.0/libpod/containers/{nameOrId}", s.handleContainerDelete)
 	mux.HandleFunc("GET", "v5.0.0/libpod/containers/{nameOrId}/json", s.handleContainerGet)
 	mux.HandleFunc("PUT", "v5.0.0/libpod/containers/{nameOrId}/archive", s.handleContainerArchive)
+	mux.HandleFunc("POST", "v5.0.0/libpod/containers/{nameOrId}/kill", s.handleContainerKill)
+	mux.HandleFunc("GET", "v5.0.0/libpod/containers/{nameOrId}/logs", s.handleContainerLogs)
+	mux.HandleFunc("POST", "v5.0.0/libpod/containers/{nameOrId}/pause", s.handleContainerPause)
 	mux.HandleFunc("POST", "v5.0.0/libpod/containers/{nameOrId}/rename", s.handleContainerRename)
+	mux.HandleFunc("POST", "v5.0.0/libpod/containers/{nameOrId}/restart", s.handleContainerRestart)
 	mux.HandleFunc("POST", "v5.0.0/libpod/containers/{nameOrId}/start", s.handleContainerStart)
 	mux.HandleFunc("POST", "v5.0.0/libpod/containers/{nameOrId}/stop", s.handleContainerStop)
+	mux.HandleFunc("POST", "v5.0.0/libpod/containers/{nameOrId}/unpause", s.handleContainerUnpause)
+	mux.HandleFunc("POST", "v5.0.0/libpod/containers/{nameOrId}/update", s.handleContainerUpdate)
+	mux.HandleFunc("POST", "v5.0.0/libpod/containers/{nameOrId}/wait", s.handleContainerWait)
+	mux.HandleFunc("POST", "v5.0.0/libpod/images/prune", s.handleImagePrune)
 	mux.HandleFunc("POST", "v5.0.0/libpod/images/pull", s.handleImagePull)
+	mux.HandleFunc("GET", "v5.0.0/libpod/images/json", s.handleImageList)
 	mux.HandleFunc("DELETE", "v5.0.0/libpod/images/{nameOrId}", s.handleImageDelete)
 	mux.HandleFunc("GET", "v5.0.0/libpod/images/{nameOrId}/json", s.handleImageGet)
 	mux.HandleFunc("POST", "v5.0.0/libpod/networks/create", s.handleNetworkCreate)
+	mux.HandleFunc("GET", "v5.0.0/libpod/networks/json", s.handleNetworkList)
+	mux.HandleFunc("POST", "v5.0.0/libpod/networks/prune", s.handleNetworkPrune)
 	mux.HandleFunc("DELETE", "v5.0.0/libpod/networks/{nameOrId}", s.handleNetworkDelete)
+	mux.HandleFunc("POST", "v5.0.0/libpod/networks/{nameOrId}/connect", s.handleNetworkConnect)
+	mux.HandleFunc("POST", "v5.0.0/libpod/networks/{nameOrId}/disconnect", s.handleNetworkDisconnect)
 	mux.HandleFunc("GET", "v5.0.0/libpod/networks/{nameOrId}/json", s.handleNetworkGet)
 	mux.HandleFunc("POST", "v5.0.0/libpod/secrets/create", s.handleSecretCreate)
+	mux.HandleFunc("GET", "v5.0.0/libpod/secrets/json", s.handleSecretList)
 	mux.HandleFunc("DELETE", "v5.0.0/libpod/secrets/{nameOrId}", s.handleSecretDelete)
 	mux.HandleFunc("GET", "v5.0.0/libpod/secrets/{nameOrId}/json", s.handleSecretGet)
+	mux.HandleFunc("GET", "v5.0.0/libpod/volumes/json", s.handleVolumeList)
+	mux.HandleFunc("POST", "v5.0.0/libpod/volumes/prune", s.handleVolumePrune)
 
 	return mux
 }
@@ -0,0 +1,91 @@
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+)
+
+// A simplified model of the `filters` query parameter accepted by libpod's list endpoints. Values
+// of the same filter are ORed together, except for `label` whose values must all match, and
+// different filters are ANDed together.
+type listFilters api.ListFilters
+
+func readFilters(req *http.Request, supported ...string) (listFilters, error) {
+	filters := make(listFilters)
+	str := req.URL.Query().Get("filters")
+
+	if str == "" {
+		return filters, nil
+	}
+
+	err := json.Unmarshal([]byte(str), &filters)
+
+	if err != nil {
+		return nil, statusError{
+			Code:    http.StatusBadRequest,
+			Message: fmt.Sprintf("invalid filters: %s", err.Error()),
+		}
+	}
+
+	for key := range filters {
+		if !slices.Contains(supported, key) {
+			return nil, statusError{
+				Code:    http.StatusInternalServerError,
+				Message: fmt.Sprintf("%s is an invalid filter", key),
+			}
+		}
+	}
+
+	return filters, nil
+}
+
+func (f listFilters) matchLabels(labels map[string]string) bool {
+	for _, filter := range f["label"] {
+		key, value, hasValue := strings.Cut(filter, "=")
+		actual, ok := labels[key]
+
+		if !ok || (hasValue && actual != value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Matches filters such as `dangling` and `status` whose values are compared exactly.
+func (f listFilters) matchValue(key string, value string) bool {
+	values, ok := f[key]
+
+	return !ok || slices.Contains(values, value)
+}
+
+// Names are matched as unanchored regular expressions, as they are in Podman.
+func (f listFilters) matchNames(key string, names ...string) bool {
+	patterns, ok := f[key]
+
+	if !ok {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+
+		if err != nil {
+			continue
+		}
+
+		for _, name := range names {
+			if re.MatchString(name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"slices"
+	"strconv"
 
 	"github.com/decafcode/terraform-provider-podman/internal/api"
 )
@@ -80,7 +81,77 @@ func (s *ApiServer) handleImageGet(ctx context.Context, resp http.ResponseWriter
 		return err
 	}
 
-	return writeJson(resp, match)
+	// The inspect endpoint reports names as RepoTags, unlike the list endpoint.
+	result := *match
+	result.RepoTags = match.Names
+
+	return writeJson(resp, result)
+}
+
+func (s *ApiServer) handleImageList(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	filters, err := readFilters(req, "containers", "dangling", "label", "reference")
+
+	if err != nil {
+		return err
+	}
+
+	result := make([]api.ImageJson, 0)
+
+	for _, img := range s.Images {
+		if filters.matchNames("reference", img.Names...) &&
+			filters.matchLabels(img.Labels) &&
+			filters.matchValue("containers", strconv.FormatBool(s.imageInUse(img))) &&
+			filters.matchValue("dangling", strconv.FormatBool(len(img.Names) == 0)) {
+
+			item := *img
+			item.RepoTags = img.Names
+			result = append(result, item)
+		}
+	}
+
+	return writeJson(resp, result)
+}
+
+func (s *ApiServer) imageInUse(img *api.ImageJson) bool {
+	return slices.ContainsFunc(s.Containers, func(c *TestContainer) bool {
+		return c.Json.Image == img.Id || slices.Contains(img.Names, c.Json.Image)
+	})
+}
+
+// Images are assumed to have no build cache.
+func (s *ApiServer) handleImagePrune(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	filters, err := readFilters(req, "label")
+
+	if err != nil {
+		return err
+	}
+
+	all := req.URL.Query().Get("all") == "true"
+	result := make([]api.PruneReportJson, 0)
+
+	s.Images = slices.DeleteFunc(s.Images, func(img *api.ImageJson) bool {
+		if s.imageInUse(img) || (!all && len(img.Names) > 0) || !filters.matchLabels(img.Labels) {
+			return false
+		}
+
+		if failure, ok := s.pruneFailure(img.Id); ok {
+			result = append(result, failure)
+
+			return false
+		}
+
+		result = append(result, api.PruneReportJson{Id: img.Id, Size: uint64(img.Size)})
+
+		return true
+	})
+
+	return writeJson(resp, result)
 }
 
 func (s *ApiServer) handleImagePull(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
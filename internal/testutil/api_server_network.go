@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"slices"
+	"strconv"
 
 	"github.com/decafcode/terraform-provider-podman/internal/api"
 )
@@ -22,6 +23,57 @@ func (s *ApiServer) lookupNetwork(nameOrId string) (*api.NetworkJson, error) {
 	}
 }
 
+// Returns the key under which a container's create JSON refers to a network, which may be either
+// the network's name or its ID.
+func containerNetworkKey(c *TestContainer, n *api.NetworkJson) (string, bool) {
+	for _, key := range []string{n.Name, n.Id} {
+		if _, ok := c.Json.Networks[key]; ok {
+			return key, true
+		}
+	}
+
+	return "", false
+}
+
+func (s *ApiServer) handleNetworkConnect(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var json api.NetworkConnectJson
+	err := readJson(req, &json)
+
+	if err != nil {
+		return err
+	}
+
+	network, err := s.lookupNetwork(req.PathValue("nameOrId"))
+
+	if err != nil {
+		return err
+	}
+
+	match, err := s.lookupContainer(json.Container)
+
+	if err != nil {
+		return err
+	}
+
+	if _, ok := containerNetworkKey(match, network); ok {
+		return statusError{
+			Code:    http.StatusInternalServerError,
+			Message: fmt.Sprintf("container %s is already connected to network %s", match.Id, network.Name),
+		}
+	}
+
+	if match.Json.Networks == nil {
+		match.Json.Networks = make(map[string]api.ContainerCreateNetworkJson)
+	}
+
+	match.Json.Networks[network.Name] = json.ContainerCreateNetworkJson
+
+	return nil
+}
+
 func (s *ApiServer) handleNetworkCreate(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -66,6 +118,43 @@ func (s *ApiServer) handleNetworkDelete(ctx context.Context, resp http.ResponseW
 	return nil
 }
 
+func (s *ApiServer) handleNetworkDisconnect(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var json api.NetworkDisconnectJson
+	err := readJson(req, &json)
+
+	if err != nil {
+		return err
+	}
+
+	network, err := s.lookupNetwork(req.PathValue("nameOrId"))
+
+	if err != nil {
+		return err
+	}
+
+	match, err := s.lookupContainer(json.Container)
+
+	if err != nil {
+		return err
+	}
+
+	key, ok := containerNetworkKey(match, network)
+
+	if !ok {
+		return statusError{
+			Code:    http.StatusInternalServerError,
+			Message: fmt.Sprintf("container %s is not connected to network %s", match.Id, network.Name),
+		}
+	}
+
+	delete(match.Json.Networks, key)
+
+	return nil
+}
+
 func (s *ApiServer) handleNetworkGet(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -80,6 +169,64 @@ func (s *ApiServer) handleNetworkGet(ctx context.Context, resp http.ResponseWrit
 	return writeJson(resp, match)
 }
 
+func (s *ApiServer) handleNetworkList(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	filters, err := readFilters(req, "dangling", "label", "name")
+
+	if err != nil {
+		return err
+	}
+
+	result := make([]*api.NetworkJson, 0)
+
+	for _, n := range s.Networks {
+		if filters.matchNames("name", n.Name) &&
+			filters.matchLabels(n.Labels) &&
+			filters.matchValue("dangling", strconv.FormatBool(!s.networkInUse(n))) {
+
+			result = append(result, n)
+		}
+	}
+
+	return writeJson(resp, result)
+}
+
+func (s *ApiServer) networkInUse(n *api.NetworkJson) bool {
+	return slices.ContainsFunc(s.Containers, func(c *TestContainer) bool {
+		_, byName := c.Json.Networks[n.Name]
+		_, byId := c.Json.Networks[n.Id]
+
+		return byName || byId
+	})
+}
+
+func (s *ApiServer) handleNetworkPrune(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	filters, err := readFilters(req, "label")
+
+	if err != nil {
+		return err
+	}
+
+	result := make([]api.NetworkPruneReportJson, 0)
+
+	s.Networks = slices.DeleteFunc(s.Networks, func(n *api.NetworkJson) bool {
+		if s.networkInUse(n) || !filters.matchLabels(n.Labels) {
+			return false
+		}
+
+		result = append(result, api.NetworkPruneReportJson{Name: n.Name})
+
+		return true
+	})
+
+	return writeJson(resp, result)
+}
+
 func (s *ApiServer) NetworkWalk(callback func(c *api.NetworkJson) error) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -6,6 +6,13 @@ import (
 )
 
 func (s *ApiServer) handlePing(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	version := s.ApiVersion
+
+	if version == "" {
+		version = "5.5.2"
+	}
+
+	resp.Header().Set("Libpod-API-Version", version)
 	resp.WriteHeader(http.StatusOK)
 	_, err := resp.Write([]byte("OK"))
 
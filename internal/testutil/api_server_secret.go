@@ -102,6 +102,31 @@ func (s *ApiServer) handleSecretGet(ctx context.Context, resp http.ResponseWrite
 	return writeJson(resp, match)
 }
 
+// Like the real endpoint, this does not return the secret data.
+func (s *ApiServer) handleSecretList(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	filters, err := readFilters(req, "label", "name")
+
+	if err != nil {
+		return err
+	}
+
+	result := make([]api.SecretInspectJson, 0)
+
+	for _, secret := range s.Secrets {
+		if filters.matchNames("name", secret.Spec.Name) && filters.matchLabels(secret.Spec.Labels) {
+			result = append(result, api.SecretInspectJson{
+				Id:   secret.Id,
+				Spec: secret.Spec,
+			})
+		}
+	}
+
+	return writeJson(resp, result)
+}
+
 func (s *ApiServer) SecretWalk(callback func(c *api.SecretInspectJson) error) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -0,0 +1,78 @@
+package testutil
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/decafcode/terraform-provider-podman/internal/api"
+)
+
+// Containers in the test server do not mount volumes, so every volume is considered dangling.
+
+func (s *ApiServer) handleVolumeList(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	filters, err := readFilters(req, "dangling", "label")
+
+	if err != nil {
+		return err
+	}
+
+	result := make([]*api.VolumeJson, 0)
+
+	for _, v := range s.Volumes {
+		if filters.matchLabels(v.Labels) && filters.matchValue("dangling", "true") {
+			result = append(result, v)
+		}
+	}
+
+	return writeJson(resp, result)
+}
+
+func (s *ApiServer) handleVolumePrune(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	filters, err := readFilters(req, "label")
+
+	if err != nil {
+		return err
+	}
+
+	result := make([]api.PruneReportJson, 0)
+
+	s.Volumes = slices.DeleteFunc(s.Volumes, func(v *api.VolumeJson) bool {
+		if !filters.matchLabels(v.Labels) {
+			return false
+		}
+
+		if failure, ok := s.pruneFailure(v.Name); ok {
+			result = append(result, failure)
+
+			return false
+		}
+
+		result = append(result, api.PruneReportJson{Id: v.Name})
+
+		return true
+	})
+
+	return writeJson(resp, result)
+}
+
+func (s *ApiServer) VolumeWalk(callback func(v *api.VolumeJson) error) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, v := range s.Volumes {
+		err := callback(v)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}